@@ -0,0 +1,174 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// lastConnID is the ID given to the most recently opened connection.
+var lastConnID uint64
+
+type connIDKey struct{}
+
+// ConnID returns the ID of the connection an event happened on. Each connection opened
+// through this package is given an ID that is unique within the process. ConnID can
+// be called with the context passed to ContextHook and the optional hook interfaces,
+// ok is false for events that did not happen on a connection, such as a failed open.
+func ConnID(ctx context.Context) (id uint64, ok bool) {
+	id, ok = ctx.Value(connIDKey{}).(uint64)
+	return id, ok
+}
+
+// statsConn implements every optional connection interface that database/sql checks
+// for. It is never returned to database/sql directly, wrapConn is used to expose only
+// the interfaces the wrapped connection implements. Each optional method can therefore
+// assume the wrapped connection implements the matching interface.
+type statsConn struct {
+	d       *statsDriver // the driver in which to store stats
+	wrapped driver.Conn  // the wrapped connection
+	id      uint64
+	opened  time.Time
+	tx      *statsTx // the transaction in progress on the connection, if any
+
+	queries int64 // accessed atomically
+	execs   int64 // accessed atomically
+}
+
+// context returns ctx annotated with the connection's ID.
+func (c *statsConn) context(ctx context.Context) context.Context {
+	return context.WithValue(ctx, connIDKey{}, c.id)
+}
+
+func (c *statsConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *statsConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	res, err := c.d.run(ctx, &Op{Kind: OpPrepare, Query: query, conn: c})
+	if err != nil {
+		return res.Stmt, err
+	}
+	return wrapStmt(&statsStmt{d: c.d, conn: c, wrapped: res.Stmt, query: query}), nil
+}
+
+func (c *statsConn) Close() error {
+	err := c.wrapped.Close()
+	ctx := c.context(context.Background())
+	c.d.ConnClosed(ctx, err)
+	c.d.ConnClosedStats(ctx, ConnStats{
+		ID:      c.id,
+		Age:     time.Now().Sub(c.opened),
+		Queries: int(atomic.LoadInt64(&c.queries)),
+		Execs:   int(atomic.LoadInt64(&c.execs)),
+	}, err)
+	return err
+}
+
+func (c *statsConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *statsConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx := &statsTx{d: c.d, conn: c, ctx: ctx}
+	res, err := c.d.run(ctx, &Op{Kind: OpBegin, TxOptions: opts, conn: c, tx: tx})
+	if err != nil {
+		// An interceptor may have begun the transaction and then failed the Op.
+		if res.Tx != nil {
+			if rerr := res.Tx.Rollback(); rerr != nil {
+				err = errors.Join(err, rerr)
+			}
+		}
+		return nil, err
+	}
+	tx.wrapped = res.Tx
+	return tx, nil
+}
+
+func (c *statsConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return c.QueryContext(context.Background(), query, valueToNamedValue(args))
+}
+
+func (c *statsConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res, err := c.d.run(ctx, &Op{Kind: OpQuery, Query: query, Args: args, conn: c})
+	return res.Rows, err
+}
+
+func (c *statsConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return c.ExecContext(context.Background(), query, valueToNamedValue(args))
+}
+
+func (c *statsConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res, err := c.d.run(ctx, &Op{Kind: OpExec, Query: query, Args: args, conn: c})
+	return res.Result, err
+}
+
+func (c *statsConn) Ping(ctx context.Context) error {
+	_, err := c.d.run(ctx, &Op{Kind: OpPing, conn: c})
+	return err
+}
+
+func (c *statsConn) ResetSession(ctx context.Context) error {
+	start := time.Now()
+	err := c.wrapped.(driver.SessionResetter).ResetSession(ctx)
+	c.d.SessionReset(c.context(ctx), time.Now().Sub(start), err)
+	return err
+}
+
+func (c *statsConn) IsValid() bool {
+	valid := c.wrapped.(driver.Validator).IsValid()
+	if !valid {
+		c.d.ConnInvalidated(c.context(context.Background()))
+	}
+	return valid
+}
+
+func (c *statsConn) CheckNamedValue(nv *driver.NamedValue) error {
+	return c.wrapped.(driver.NamedValueChecker).CheckNamedValue(nv)
+}
+
+// The methods below pass operations to the wrapped connection once they have been
+// through the interceptor chain. As an operation can reach them through either the
+// context aware or the older interface, they use whichever the wrapped connection
+// implements, preferring the context aware one.
+
+func (c *statsConn) runQuery(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if qc, ok := c.wrapped.(driver.QueryerContext); ok {
+		return qc.QueryContext(ctx, query, args)
+	}
+	dargs, err := namedValueToValue(args)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrapped.(driver.Queryer).Query(query, dargs)
+}
+
+func (c *statsConn) runExec(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if ec, ok := c.wrapped.(driver.ExecerContext); ok {
+		return ec.ExecContext(ctx, query, args)
+	}
+	dargs, err := namedValueToValue(args)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrapped.(driver.Execer).Exec(query, dargs)
+}
+
+func (c *statsConn) runPrepare(ctx context.Context, query string) (driver.Stmt, error) {
+	if cpc, ok := c.wrapped.(driver.ConnPrepareContext); ok {
+		return cpc.PrepareContext(ctx, query)
+	}
+	return c.wrapped.Prepare(query)
+}
+
+func (c *statsConn) runBegin(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if cbt, ok := c.wrapped.(driver.ConnBeginTx); ok {
+		return cbt.BeginTx(ctx, opts)
+	}
+	if opts != (driver.TxOptions{}) {
+		return nil, errors.New("sql: driver does not support non-default transaction options")
+	}
+	return c.wrapped.Begin()
+}
@@ -0,0 +1,111 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"time"
+)
+
+// Connector is a driver.Connector that wraps another driver.Connector. It can be
+// passed to sql.OpenDB in order to gather stats for drivers that are configured
+// through a Connector rather than a data source name.
+type Connector interface {
+	driver.Connector
+
+	// AddHook will add a Hook to be called when various database events occurs. It has
+	// the same semantics as Driver.AddHook. Hooks added to a Connector are shared with
+	// the Driver returned by its Driver method.
+	AddHook(h Hook)
+
+	// RemoveHook removes a Hook added with AddHook. It has the same semantics as
+	// Driver.RemoveHook.
+	RemoveHook(h Hook)
+
+	// SetRedactPolicy sets the policy used to mask query arguments before they are
+	// passed to ArgsHooks. It has the same semantics as Driver.SetRedactPolicy.
+	SetRedactPolicy(p RedactPolicy)
+
+	// AddInterceptor adds an Interceptor to the end of the Connector's interceptor
+	// chain. It has the same semantics as Driver.AddInterceptor.
+	AddInterceptor(i Interceptor)
+}
+
+// NewConnector wraps c so that the connections it creates report their activity to
+// the Connector's hooks.
+func NewConnector(c driver.Connector) Connector {
+	wd := c.Driver()
+	d := newStatsDriver(wd.Open)
+	if dc, ok := wd.(driver.DriverContext); ok {
+		d.openConnector = dc.OpenConnector
+	}
+	return wrapConnector(&statsConnector{d: d, wrapped: c})
+}
+
+// wrapConnector returns c, also implementing io.Closer if the connector it wraps does.
+// database/sql closes connectors that implement io.Closer when the sql.DB using them is
+// closed.
+func wrapConnector(c *statsConnector) Connector {
+	if _, ok := c.wrapped.(io.Closer); ok {
+		return closingConnector{c}
+	}
+	return c
+}
+
+type statsConnector struct {
+	d       *statsDriver
+	wrapped driver.Connector
+}
+
+// closingConnector is a statsConnector whose wrapped connector implements io.Closer.
+type closingConnector struct {
+	*statsConnector
+}
+
+func (c closingConnector) Close() error {
+	return c.wrapped.(io.Closer).Close()
+}
+
+func (c *statsConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	fs := c.d.ConnOpenStarted(ctx)
+	start := time.Now()
+	conn, err := c.wrapped.Connect(ctx)
+	fs.finish(err)
+	return c.d.opened(ctx, start, conn, err)
+}
+
+func (c *statsConnector) Driver() driver.Driver {
+	return c.d
+}
+
+func (c *statsConnector) AddHook(h Hook) {
+	c.d.AddHook(h)
+}
+
+func (c *statsConnector) RemoveHook(h Hook) {
+	c.d.RemoveHook(h)
+}
+
+func (c *statsConnector) SetRedactPolicy(p RedactPolicy) {
+	c.d.SetRedactPolicy(p)
+}
+
+func (c *statsConnector) AddInterceptor(i Interceptor) {
+	c.d.AddInterceptor(i)
+}
+
+// dsnConnector is used by statsDriver.OpenConnector when the wrapped driver does not
+// implement driver.DriverContext. It mirrors the connector database/sql uses in the
+// same situation.
+type dsnConnector struct {
+	dsn  string
+	open OpenFunc
+}
+
+func (c dsnConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.open
+}
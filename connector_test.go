@@ -0,0 +1,164 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+type fakeConnector struct {
+	d           *fakeDriver
+	name        string
+	connectErr  error
+	connectCtxs []context.Context
+}
+
+func (c *fakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	c.connectCtxs = append(c.connectCtxs, ctx)
+	if c.connectErr != nil {
+		return nil, c.connectErr
+	}
+	return c.d.Open(c.name)
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return &fakeContextDriver{fakeDriver: c.d}
+}
+
+type fakeContextDriver struct {
+	*fakeDriver
+}
+
+func (d *fakeContextDriver) OpenConnector(name string) (driver.Connector, error) {
+	return &fakeConnector{d: d.fakeDriver, name: name}, nil
+}
+
+func TestConnectorKeepsConnectionStats(t *testing.T) {
+	reset()
+	fc := &fakeConnector{d: &fakeDriver{isQueryer: true}}
+	c := NewConnector(fc)
+	h := &fakeHook{}
+	c.AddHook(h)
+	db := sql.OpenDB(c)
+
+	rows, err := db.Query("SELECT c0, c1 FROM my_table WHERE myvar=?", 1)
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	rows.Close()
+	db.Close()
+
+	switch {
+	case len(fc.connectCtxs) != 1:
+		t.Errorf("Expected Connect to be called 1 time, got %d", len(fc.connectCtxs))
+	case !queryerCalled:
+		t.Errorf("Expected Queryer interface to be called")
+	case h.connOpenedCount != 1:
+		t.Errorf("Expected ConnOpened to be called 1 time, got %d", h.connOpenedCount)
+	case h.queriedCount != 1:
+		t.Errorf("Expected Queried to be called 1 time, got %d", h.queriedCount)
+	case h.connClosedCount != 1:
+		t.Errorf("Expected ConnClosed to be called 1 time, got %d", h.connClosedCount)
+	}
+}
+
+func TestConnectorConnectReturnsErr(t *testing.T) {
+	reset()
+	myErr := errors.New("failed to connect")
+	c := NewConnector(&fakeConnector{d: fake, connectErr: myErr})
+	h := &fakeHook{}
+	c.AddHook(h)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	err := db.Ping()
+	switch {
+	case err != myErr:
+		t.Errorf("Expected error to be returned, got %v", err)
+	case h.connOpenedCount != 1:
+		t.Errorf("Expected ConnOpened to be called")
+	case h.numErr == 0:
+		t.Errorf("Expected error to be passed to hook")
+	case len(h.openErrs) != 1 || h.openErrs[0] != myErr:
+		t.Errorf("Expected ConnOpenedWithDuration to get the error, got %v", h.openErrs)
+	}
+}
+
+func TestConnectorDriverOpenConnectorWrapsWrapped(t *testing.T) {
+	reset()
+	c := NewConnector(&fakeConnector{d: fake})
+	h := &fakeHook{}
+	c.AddHook(h)
+
+	dc, ok := c.Driver().(driver.DriverContext)
+	if !ok {
+		t.Fatalf("Expected Connector.Driver to implement driver.DriverContext")
+	}
+	c2, err := dc.OpenConnector("my params")
+	if err != nil {
+		t.Fatalf("OpenConnector returned error: %v", err)
+	}
+	conn, err := c2.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("Connect returned error: %v", err)
+	}
+	conn.Close()
+
+	switch {
+	case len(fake.openNames) != 1 || fake.openNames[0] != "my params":
+		t.Errorf("Expected connection to be opened through the wrapped connector, got %v", fake.openNames)
+	case h.connOpenedCount != 1:
+		t.Errorf("Expected ConnOpened to be called 1 time, got %d", h.connOpenedCount)
+	case h.connClosedCount != 1:
+		t.Errorf("Expected ConnClosed to be called 1 time, got %d", h.connClosedCount)
+	}
+}
+
+func TestDriverOpenConnector(t *testing.T) {
+	reset()
+	c, err := stats.OpenConnector("my params")
+	if err != nil {
+		t.Fatalf("OpenConnector returned error: %v", err)
+	}
+	if c.Driver() != stats {
+		t.Errorf("Expected Connector.Driver to return the stats driver")
+	}
+	conn, err := c.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("Connect returned error: %v", err)
+	}
+	conn.Close()
+
+	switch {
+	case len(fake.openNames) != 1 || fake.openNames[0] != "my params":
+		t.Errorf("Expected connection to be opened with the driver's OpenFunc, got %v", fake.openNames)
+	case hook.connOpenedCount != 1:
+		t.Errorf("Expected ConnOpened to be called 1 time, got %d", hook.connOpenedCount)
+	}
+}
+
+type fakeClosingConnector struct {
+	fakeConnector
+	closed int
+}
+
+func (c *fakeClosingConnector) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestConnectorForwardsClose(t *testing.T) {
+	reset()
+	fc := &fakeClosingConnector{fakeConnector: fakeConnector{d: fake}}
+	sql.OpenDB(NewConnector(fc)).Close()
+	if fc.closed != 1 {
+		t.Errorf("Expected wrapped connector to be closed 1 time, got %d", fc.closed)
+	}
+
+	if _, ok := NewConnector(&fakeConnector{d: fake}).(io.Closer); ok {
+		t.Errorf("Expected Connector not to implement io.Closer when the wrapped connector does not")
+	}
+}
@@ -1,6 +1,10 @@
 package dbstats
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"sync/atomic"
 	"time"
 )
@@ -8,16 +12,30 @@ import (
 // CounterHook is a Hook that keeps counters of various stats with
 // respect to database usage.
 type CounterHook struct {
-	openConns     int64
+	// Gauges of what is currently open. Unlike the counters they are not zeroed by
+	// Reset.
+	openConns int64
+	openStmts int64
+	openTxs   int64
+
+	cur atomic.Value // the current *counters, replaced by Reset
+}
+
+// counters holds the CounterHook counters that Reset zeroes.
+type counters struct {
 	totalConns    int64
-	openStmts     int64
 	totalStmts    int64
-	openTxs       int64
 	totalTxs      int64
 	committedTxs  int64
 	rolledbackTxs int64
+	serialTxs     int64
+	readOnlyTxs   int64
 	queries       int64
 	execs         int64
+	pings         int64
+	resets        int64
+	invalidConns  int64
+	badConns      int64
 	rowsIterated  int64
 
 	connErrs    int64
@@ -26,7 +44,26 @@ type CounterHook struct {
 	txCloseErrs int64
 	queryErrs   int64
 	execErrs    int64
+	pingErrs    int64
+	resetErrs   int64
 	rowErrs     int64
+
+	queryLatency latency
+	execLatency  latency
+	txLatency    latency
+	openLatency  latency
+}
+
+// load returns the current counters.
+//
+// Each event loads the counters once and updates only those, so that a Reset never
+// splits an event's updates between the counters before and after it.
+func (h *CounterHook) load() *counters {
+	if c, ok := h.cur.Load().(*counters); ok {
+		return c
+	}
+	h.cur.CompareAndSwap(nil, &counters{})
+	return h.cur.Load().(*counters)
 }
 
 // OpenConns returns the current count of open connections.
@@ -36,7 +73,7 @@ func (h *CounterHook) OpenConns() int {
 
 // TotalConns returns the total number of connections ever made.
 func (h *CounterHook) TotalConns() int {
-	return int(atomic.LoadInt64(&h.totalConns))
+	return int(atomic.LoadInt64(&h.load().totalConns))
 }
 
 // OpenStmts returns the current count of prepared statements.
@@ -46,7 +83,7 @@ func (h *CounterHook) OpenStmts() int {
 
 // TotalStmts returns the total number of prepared statements ever made.
 func (h *CounterHook) TotalStmts() int {
-	return int(atomic.LoadInt64(&h.totalStmts))
+	return int(atomic.LoadInt64(&h.load().totalStmts))
 }
 
 // OpenTxs returns the current number of open transactions
@@ -56,77 +93,280 @@ func (h *CounterHook) OpenTxs() int {
 
 // TotalTxs returns the total number of transactions ever openned.
 func (h *CounterHook) TotalTxs() int {
-	return int(atomic.LoadInt64(&h.totalTxs))
+	return int(atomic.LoadInt64(&h.load().totalTxs))
 }
 
 // CommittedTxs returns the total number of transactions that were committed.
 func (h *CounterHook) CommittedTxs() int {
-	return int(atomic.LoadInt64(&h.committedTxs))
+	return int(atomic.LoadInt64(&h.load().committedTxs))
 }
 
 // RolledbackTxs returns the total number of transactions there were rolled back.
 func (h *CounterHook) RolledbackTxs() int {
-	return int(atomic.LoadInt64(&h.rolledbackTxs))
+	return int(atomic.LoadInt64(&h.load().rolledbackTxs))
+}
+
+// SerializableTxs returns the total number of transactions started with the
+// serializable isolation level.
+func (h *CounterHook) SerializableTxs() int {
+	return int(atomic.LoadInt64(&h.load().serialTxs))
+}
+
+// ReadOnlyTxs returns the total number of read-only transactions started.
+func (h *CounterHook) ReadOnlyTxs() int {
+	return int(atomic.LoadInt64(&h.load().readOnlyTxs))
 }
 
 // Queries returns the total number of Query statements ran.
 func (h *CounterHook) Queries() int {
-	return int(atomic.LoadInt64(&h.queries))
+	return int(atomic.LoadInt64(&h.load().queries))
 }
 
 // Execs returns the total number of Exex statements ran.
 func (h *CounterHook) Execs() int {
-	return int(atomic.LoadInt64(&h.execs))
+	return int(atomic.LoadInt64(&h.load().execs))
+}
+
+// Pings returns the total number of successful pings.
+func (h *CounterHook) Pings() int {
+	return int(atomic.LoadInt64(&h.load().pings))
+}
+
+// SessionResets returns the total number of times a connection's session was
+// successfully reset before being reused.
+func (h *CounterHook) SessionResets() int {
+	return int(atomic.LoadInt64(&h.load().resets))
+}
+
+// InvalidConns returns the total number of connections that were discarded because
+// the driver reported them as invalid.
+func (h *CounterHook) InvalidConns() int {
+	return int(atomic.LoadInt64(&h.load().invalidConns))
+}
+
+// BadConns returns the total number of operations that failed with driver.ErrBadConn.
+// database/sql usually retries these successfully, see BadConnHook, so they are counted
+// here instead of by the error counters such as QueryErrs and ExecErrs. The error
+// counters therefore miss driver.ErrBadConn failures the application did see: the rare
+// operation whose every retry failed, and operations in a transaction or on a sql.Conn,
+// which are not retried. BadConns includes them.
+func (h *CounterHook) BadConns() int {
+	return int(atomic.LoadInt64(&h.load().badConns))
 }
 
 // RowsIterated returns the total number of rows that have been iterated through.
 func (h *CounterHook) RowsIterated() int {
-	return int(atomic.LoadInt64(&h.rowsIterated))
+	return int(atomic.LoadInt64(&h.load().rowsIterated))
 }
 
 // ConnErrs returns the number of errors encountered trying to open a connection.
 func (h *CounterHook) ConnErrs() int {
-	return int(atomic.LoadInt64(&h.connErrs))
+	return int(atomic.LoadInt64(&h.load().connErrs))
 }
 
-// StmtErrs returns the number of errors encountered trying to prepare a statement.
+// StmtErrs returns the number of errors encountered trying to prepare a statement. It does
+// not include driver.ErrBadConn failures, see BadConns.
 func (h *CounterHook) StmtErrs() int {
-	return int(atomic.LoadInt64(&h.stmtErrs))
+	return int(atomic.LoadInt64(&h.load().stmtErrs))
 }
 
-// TxOpenErrs returns the number of errors encountered trying to start a transaction.
+// TxOpenErrs returns the number of errors encountered trying to start a transaction. It does
+// not include driver.ErrBadConn failures, see BadConns.
 func (h *CounterHook) TxOpenErrs() int {
-	return int(atomic.LoadInt64(&h.txOpenErrs))
+	return int(atomic.LoadInt64(&h.load().txOpenErrs))
 }
 
 // TxCloseErrs returns the number of errors encountered trying to Commit or Rollback
 // a transacttion.
 func (h *CounterHook) TxCloseErrs() int {
-	return int(atomic.LoadInt64(&h.txCloseErrs))
+	return int(atomic.LoadInt64(&h.load().txCloseErrs))
 }
 
-// QueryErrs returns the number of errors encountered trying to run a query.
+// QueryErrs returns the number of errors encountered trying to run a query. It does
+// not include driver.ErrBadConn failures, see BadConns.
 func (h *CounterHook) QueryErrs() int {
-	return int(atomic.LoadInt64(&h.queryErrs))
+	return int(atomic.LoadInt64(&h.load().queryErrs))
 }
 
-// ExexErrs returns the number of errors encountered trying to exec command.
+// ExexErrs returns the number of errors encountered trying to exec command. It does
+// not include driver.ErrBadConn failures, see BadConns.
 func (h *CounterHook) ExecErrs() int {
-	return int(atomic.LoadInt64(&h.execErrs))
+	return int(atomic.LoadInt64(&h.load().execErrs))
+}
+
+// PingErrs returns the number of errors encountered trying to ping a connection. It does
+// not include driver.ErrBadConn failures, see BadConns.
+func (h *CounterHook) PingErrs() int {
+	return int(atomic.LoadInt64(&h.load().pingErrs))
+}
+
+// SessionResetErrs returns the number of errors encountered trying to reset a
+// connection's session.
+func (h *CounterHook) SessionResetErrs() int {
+	return int(atomic.LoadInt64(&h.load().resetErrs))
 }
 
 // RowErrs returns the number of error encountered while iterating rows.
 func (h *CounterHook) RowErrs() int {
-	return int(atomic.LoadInt64(&h.rowErrs))
+	return int(atomic.LoadInt64(&h.load().rowErrs))
+}
+
+// QueryLatency returns the latency of queries, including those that failed.
+func (h *CounterHook) QueryLatency() Latency {
+	return h.load().queryLatency.load()
+}
+
+// ExecLatency returns the latency of execs, including those that failed.
+func (h *CounterHook) ExecLatency() Latency {
+	return h.load().execLatency.load()
+}
+
+// TxLatency returns the latency of transactions, from the start of Begin until Commit
+// or Rollback returned.
+func (h *CounterHook) TxLatency() Latency {
+	return h.load().txLatency.load()
+}
+
+// ConnOpenLatency returns the latency of attempts to open a connection, including
+// those that failed.
+func (h *CounterHook) ConnOpenLatency() Latency {
+	return h.load().openLatency.load()
+}
+
+// Stats is a point-in-time copy of a CounterHook's gauges and counters, as returned
+// by CounterHook.Snapshot. Each field holds the value of the CounterHook method of the
+// same name.
+type Stats struct {
+	OpenConns        int `json:"open_conns"`
+	TotalConns       int `json:"total_conns"`
+	OpenStmts        int `json:"open_stmts"`
+	TotalStmts       int `json:"total_stmts"`
+	OpenTxs          int `json:"open_txs"`
+	TotalTxs         int `json:"total_txs"`
+	CommittedTxs     int `json:"committed_txs"`
+	RolledbackTxs    int `json:"rolledback_txs"`
+	SerializableTxs  int `json:"serializable_txs"`
+	ReadOnlyTxs      int `json:"read_only_txs"`
+	Queries          int `json:"queries"`
+	Execs            int `json:"execs"`
+	Pings            int `json:"pings"`
+	SessionResets    int `json:"session_resets"`
+	InvalidConns     int `json:"invalid_conns"`
+	BadConns         int `json:"bad_conns"`
+	RowsIterated     int `json:"rows_iterated"`
+	ConnErrs         int `json:"conn_errs"`
+	StmtErrs         int `json:"stmt_errs"`
+	TxOpenErrs       int `json:"tx_open_errs"`
+	TxCloseErrs      int `json:"tx_close_errs"`
+	QueryErrs        int `json:"query_errs"`
+	ExecErrs         int `json:"exec_errs"`
+	PingErrs         int `json:"ping_errs"`
+	SessionResetErrs int `json:"session_reset_errs"`
+	RowErrs          int `json:"row_errs"`
+
+	QueryLatency    Latency `json:"query_latency"`
+	ExecLatency     Latency `json:"exec_latency"`
+	TxLatency       Latency `json:"tx_latency"`
+	ConnOpenLatency Latency `json:"conn_open_latency"`
+}
+
+// Snapshot returns the current value of every gauge and counter. Each value is read
+// atomically, but as updates are not blocked while Snapshot runs the values may not all
+// reflect exactly the same moment.
+func (h *CounterHook) Snapshot() Stats {
+	return h.snapshot(h.load())
+}
+
+func (h *CounterHook) snapshot(c *counters) Stats {
+	return Stats{
+		OpenConns:        int(atomic.LoadInt64(&h.openConns)),
+		TotalConns:       int(atomic.LoadInt64(&c.totalConns)),
+		OpenStmts:        int(atomic.LoadInt64(&h.openStmts)),
+		TotalStmts:       int(atomic.LoadInt64(&c.totalStmts)),
+		OpenTxs:          int(atomic.LoadInt64(&h.openTxs)),
+		TotalTxs:         int(atomic.LoadInt64(&c.totalTxs)),
+		CommittedTxs:     int(atomic.LoadInt64(&c.committedTxs)),
+		RolledbackTxs:    int(atomic.LoadInt64(&c.rolledbackTxs)),
+		SerializableTxs:  int(atomic.LoadInt64(&c.serialTxs)),
+		ReadOnlyTxs:      int(atomic.LoadInt64(&c.readOnlyTxs)),
+		Queries:          int(atomic.LoadInt64(&c.queries)),
+		Execs:            int(atomic.LoadInt64(&c.execs)),
+		Pings:            int(atomic.LoadInt64(&c.pings)),
+		SessionResets:    int(atomic.LoadInt64(&c.resets)),
+		InvalidConns:     int(atomic.LoadInt64(&c.invalidConns)),
+		BadConns:         int(atomic.LoadInt64(&c.badConns)),
+		RowsIterated:     int(atomic.LoadInt64(&c.rowsIterated)),
+		ConnErrs:         int(atomic.LoadInt64(&c.connErrs)),
+		StmtErrs:         int(atomic.LoadInt64(&c.stmtErrs)),
+		TxOpenErrs:       int(atomic.LoadInt64(&c.txOpenErrs)),
+		TxCloseErrs:      int(atomic.LoadInt64(&c.txCloseErrs)),
+		QueryErrs:        int(atomic.LoadInt64(&c.queryErrs)),
+		ExecErrs:         int(atomic.LoadInt64(&c.execErrs)),
+		PingErrs:         int(atomic.LoadInt64(&c.pingErrs)),
+		SessionResetErrs: int(atomic.LoadInt64(&c.resetErrs)),
+		RowErrs:          int(atomic.LoadInt64(&c.rowErrs)),
+		QueryLatency:     c.queryLatency.load(),
+		ExecLatency:      c.execLatency.load(),
+		TxLatency:        c.txLatency.load(),
+		ConnOpenLatency:  c.openLatency.load(),
+	}
+}
+
+// Reset zeroes the counters and returns a Snapshot of their values just before they
+// were zeroed. The gauges (OpenConns, OpenStmts and OpenTxs) are not reset. The counters
+// are all replaced at once, so no update is counted both before and after a Reset,
+// though updates that race with Reset may be missed by both.
+func (h *CounterHook) Reset() Stats {
+	old, ok := h.cur.Swap(&counters{}).(*counters)
+	if !ok {
+		old = &counters{}
+	}
+	return h.snapshot(old)
+}
+
+// Sub returns the difference between s and an earlier Stats o, for example the
+// activity between two Snapshots. Counters are subtracted while the gauges are taken
+// from s, as they describe a moment rather than an interval.
+func (s Stats) Sub(o Stats) Stats {
+	d := s
+	d.TotalConns -= o.TotalConns
+	d.TotalStmts -= o.TotalStmts
+	d.TotalTxs -= o.TotalTxs
+	d.CommittedTxs -= o.CommittedTxs
+	d.RolledbackTxs -= o.RolledbackTxs
+	d.SerializableTxs -= o.SerializableTxs
+	d.ReadOnlyTxs -= o.ReadOnlyTxs
+	d.Queries -= o.Queries
+	d.Execs -= o.Execs
+	d.Pings -= o.Pings
+	d.SessionResets -= o.SessionResets
+	d.InvalidConns -= o.InvalidConns
+	d.BadConns -= o.BadConns
+	d.RowsIterated -= o.RowsIterated
+	d.ConnErrs -= o.ConnErrs
+	d.StmtErrs -= o.StmtErrs
+	d.TxOpenErrs -= o.TxOpenErrs
+	d.TxCloseErrs -= o.TxCloseErrs
+	d.QueryErrs -= o.QueryErrs
+	d.ExecErrs -= o.ExecErrs
+	d.PingErrs -= o.PingErrs
+	d.SessionResetErrs -= o.SessionResetErrs
+	d.RowErrs -= o.RowErrs
+	d.QueryLatency = s.QueryLatency.Sub(o.QueryLatency)
+	d.ExecLatency = s.ExecLatency.Sub(o.ExecLatency)
+	d.TxLatency = s.TxLatency.Sub(o.TxLatency)
+	d.ConnOpenLatency = s.ConnOpenLatency.Sub(o.ConnOpenLatency)
+	return d
 }
 
 // ConnOpened implements ConnOpened of the Hook interface.
 func (h *CounterHook) ConnOpened(err error) {
+	c := h.load()
 	if err == nil {
 		atomic.AddInt64(&h.openConns, 1)
-		atomic.AddInt64(&h.totalConns, 1)
+		atomic.AddInt64(&c.totalConns, 1)
 	} else {
-		atomic.AddInt64(&h.connErrs, 1)
+		atomic.AddInt64(&c.connErrs, 1)
 	}
 }
 
@@ -137,11 +377,12 @@ func (h *CounterHook) ConnClosed(err error) {
 
 // StmtPrepared implements StmtPrepared of the Hook interface.
 func (h *CounterHook) StmtPrepared(query string, err error) {
+	c := h.load()
 	if err == nil {
 		atomic.AddInt64(&h.openStmts, 1)
-		atomic.AddInt64(&h.totalStmts, 1)
-	} else {
-		atomic.AddInt64(&h.stmtErrs, 1)
+		atomic.AddInt64(&c.totalStmts, 1)
+	} else if !errors.Is(err, driver.ErrBadConn) {
+		atomic.AddInt64(&c.stmtErrs, 1)
 	}
 }
 
@@ -152,57 +393,120 @@ func (h *CounterHook) StmtClosed(err error) {
 
 // TxBegan implements TxBegan of the Hook interface.
 func (h *CounterHook) TxBegan(err error) {
+	c := h.load()
 	if err == nil {
 		atomic.AddInt64(&h.openTxs, 1)
-		atomic.AddInt64(&h.totalTxs, 1)
-	} else {
-		atomic.AddInt64(&h.txOpenErrs, 1)
+		atomic.AddInt64(&c.totalTxs, 1)
+	} else if !errors.Is(err, driver.ErrBadConn) {
+		atomic.AddInt64(&c.txOpenErrs, 1)
+	}
+}
+
+// TxBeganWithOptions implements TxBeganWithOptions of the TxOptionsHook interface.
+func (h *CounterHook) TxBeganWithOptions(ctx context.Context, opts driver.TxOptions, err error) {
+	if err != nil {
+		return
+	}
+	c := h.load()
+	if opts.Isolation == driver.IsolationLevel(sql.LevelSerializable) {
+		atomic.AddInt64(&c.serialTxs, 1)
+	}
+	if opts.ReadOnly {
+		atomic.AddInt64(&c.readOnlyTxs, 1)
 	}
 }
 
 // TxCommitted implements TxCommitted of the Hook interface.
 func (h *CounterHook) TxCommitted(err error) {
+	c := h.load()
 	atomic.AddInt64(&h.openTxs, -1)
 	if err == nil {
-		atomic.AddInt64(&h.committedTxs, 1)
+		atomic.AddInt64(&c.committedTxs, 1)
 	} else {
-		atomic.AddInt64(&h.txCloseErrs, 1)
+		atomic.AddInt64(&c.txCloseErrs, 1)
 	}
 }
 
 // TxRolledback implements TxRolledback of the Hook interface.
 func (h *CounterHook) TxRolledback(err error) {
+	c := h.load()
 	atomic.AddInt64(&h.openTxs, -1)
 	if err == nil {
-		atomic.AddInt64(&h.rolledbackTxs, 1)
+		atomic.AddInt64(&c.rolledbackTxs, 1)
 	} else {
-		atomic.AddInt64(&h.txCloseErrs, 1)
+		atomic.AddInt64(&c.txCloseErrs, 1)
 	}
 }
 
 // Queried implements Queried of the Hook interface.
 func (h *CounterHook) Queried(d time.Duration, query string, err error) {
+	c := h.load()
+	c.queryLatency.record(d)
 	if err == nil {
-		atomic.AddInt64(&h.queries, 1)
-	} else {
-		atomic.AddInt64(&h.queryErrs, 1)
+		atomic.AddInt64(&c.queries, 1)
+	} else if !errors.Is(err, driver.ErrBadConn) {
+		atomic.AddInt64(&c.queryErrs, 1)
 	}
 }
 
 // Execed implements Execed of the Hook interface.
 func (h *CounterHook) Execed(d time.Duration, query string, err error) {
+	c := h.load()
+	c.execLatency.record(d)
+	if err == nil {
+		atomic.AddInt64(&c.execs, 1)
+	} else if !errors.Is(err, driver.ErrBadConn) {
+		atomic.AddInt64(&c.execErrs, 1)
+	}
+}
+
+// Pinged implements Pinged of the PingHook interface.
+func (h *CounterHook) Pinged(ctx context.Context, d time.Duration, err error) {
+	c := h.load()
+	if err == nil {
+		atomic.AddInt64(&c.pings, 1)
+	} else if !errors.Is(err, driver.ErrBadConn) {
+		atomic.AddInt64(&c.pingErrs, 1)
+	}
+}
+
+// SessionReset implements SessionReset of the SessionHook interface.
+func (h *CounterHook) SessionReset(ctx context.Context, d time.Duration, err error) {
+	c := h.load()
 	if err == nil {
-		atomic.AddInt64(&h.execs, 1)
+		atomic.AddInt64(&c.resets, 1)
 	} else {
-		atomic.AddInt64(&h.execErrs, 1)
+		atomic.AddInt64(&c.resetErrs, 1)
 	}
 }
 
+// ConnInvalidated implements ConnInvalidated of the SessionHook interface.
+func (h *CounterHook) ConnInvalidated(ctx context.Context) {
+	atomic.AddInt64(&h.load().invalidConns, 1)
+}
+
+// TxEnded implements TxEnded of the TxSummaryHook interface.
+func (h *CounterHook) TxEnded(ctx context.Context, summary TxSummary, err error) {
+	h.load().txLatency.record(summary.Duration)
+}
+
+// ConnOpenedWithDuration implements ConnOpenedWithDuration of the ConnOpenHook
+// interface.
+func (h *CounterHook) ConnOpenedWithDuration(ctx context.Context, d time.Duration, err error) {
+	h.load().openLatency.record(d)
+}
+
+// BadConn implements BadConn of the BadConnHook interface.
+func (h *CounterHook) BadConn(ctx context.Context, kind OpKind, query string) {
+	atomic.AddInt64(&h.load().badConns, 1)
+}
+
 // RowIterated implements RowIterated of the Hook interface.
 func (h *CounterHook) RowIterated(err error) {
+	c := h.load()
 	if err == nil {
-		atomic.AddInt64(&h.rowsIterated, 1)
+		atomic.AddInt64(&c.rowsIterated, 1)
 	} else {
-		atomic.AddInt64(&h.rowErrs, 1)
+		atomic.AddInt64(&c.rowErrs, 1)
 	}
 }
@@ -1,7 +1,12 @@
 package dbstats
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 )
@@ -173,3 +178,204 @@ func TestCounterHookQueriesExecsRows(t *testing.T) {
 		t.Errorf("Expected error on iterating row to increment RowErrs")
 	}
 }
+
+func TestCounterHookTxOptions(t *testing.T) {
+	h := &CounterHook{}
+	ctx := context.Background()
+
+	h.TxBeganWithOptions(ctx, driver.TxOptions{}, nil)
+	if h.SerializableTxs() != 0 || h.ReadOnlyTxs() != 0 {
+		t.Errorf("Expected default options not to increment SerializableTxs or ReadOnlyTxs")
+	}
+
+	h.TxBeganWithOptions(ctx, driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSerializable)}, nil)
+	if h.SerializableTxs() != 1 {
+		t.Errorf("Expected serializable transaction to increment SerializableTxs to 1, got %d", h.SerializableTxs())
+	}
+
+	h.TxBeganWithOptions(ctx, driver.TxOptions{ReadOnly: true}, nil)
+	if h.ReadOnlyTxs() != 1 {
+		t.Errorf("Expected read-only transaction to increment ReadOnlyTxs to 1, got %d", h.ReadOnlyTxs())
+	}
+
+	h.TxBeganWithOptions(ctx, driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSerializable), ReadOnly: true}, anErr)
+	if h.SerializableTxs() != 1 || h.ReadOnlyTxs() != 1 {
+		t.Errorf("Expected error beginning transaction not to increment SerializableTxs or ReadOnlyTxs")
+	}
+}
+
+func TestCounterHookPings(t *testing.T) {
+	h := &CounterHook{}
+
+	h.Pinged(context.Background(), time.Millisecond, nil)
+	if h.Pings() != 1 {
+		t.Errorf("Expected Pinged to increment Pings to 1, got %d", h.Pings())
+	}
+	h.Pinged(context.Background(), time.Millisecond, anErr)
+	if h.Pings() > 1 {
+		t.Errorf("Expected error on Ping to not increment Pings")
+	}
+	if h.PingErrs() != 1 {
+		t.Errorf("Expected error on Ping to increment PingErrs")
+	}
+}
+
+func TestCounterHookSessions(t *testing.T) {
+	h := &CounterHook{}
+	ctx := context.Background()
+
+	h.SessionReset(ctx, time.Millisecond, nil)
+	if h.SessionResets() != 1 {
+		t.Errorf("Expected SessionReset to increment SessionResets to 1, got %d", h.SessionResets())
+	}
+	h.SessionReset(ctx, time.Millisecond, anErr)
+	if h.SessionResets() > 1 {
+		t.Errorf("Expected error on SessionReset to not increment SessionResets")
+	}
+	if h.SessionResetErrs() != 1 {
+		t.Errorf("Expected error on SessionReset to increment SessionResetErrs")
+	}
+
+	h.ConnInvalidated(ctx)
+	if h.InvalidConns() != 1 {
+		t.Errorf("Expected ConnInvalidated to increment InvalidConns to 1, got %d", h.InvalidConns())
+	}
+}
+
+func TestCounterHookSnapshotAndSub(t *testing.T) {
+	h := &CounterHook{}
+	h.ConnOpened(nil)
+	h.Queried(time.Millisecond, "SELECT 1", nil)
+	before := h.Snapshot()
+
+	h.Queried(time.Millisecond, "SELECT 1", nil)
+	h.Queried(time.Millisecond, "SELECT 1", anErr)
+	h.Execed(time.Millisecond, "UPDATE t SET a=1", nil)
+	after := h.Snapshot()
+
+	want := Stats{
+		OpenConns: 1, TotalConns: 1, Queries: 2, QueryErrs: 1, Execs: 1,
+		QueryLatency: Latency{Count: 3, Total: 3 * time.Millisecond, Min: time.Millisecond, Max: time.Millisecond},
+		ExecLatency:  Latency{Count: 1, Total: time.Millisecond, Min: time.Millisecond, Max: time.Millisecond},
+	}
+	if after != want {
+		t.Errorf("Expected Snapshot to return %+v, got %+v", want, after)
+	}
+	want = Stats{
+		OpenConns: 1, Queries: 1, QueryErrs: 1, Execs: 1,
+		QueryLatency: Latency{Count: 2, Total: 2 * time.Millisecond, Min: time.Millisecond, Max: time.Millisecond},
+		ExecLatency:  Latency{Count: 1, Total: time.Millisecond, Min: time.Millisecond, Max: time.Millisecond},
+	}
+	if delta := after.Sub(before); delta != want {
+		t.Errorf("Expected Sub to return %+v, got %+v", want, delta)
+	}
+
+	b, err := json.Marshal(after)
+	if err != nil {
+		t.Fatalf("Failed to marshal Stats: %v", err)
+	}
+	var decoded Stats
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded != after {
+		t.Errorf("Expected Stats to round trip through JSON, got %+v (%v)", decoded, err)
+	}
+}
+
+func TestCounterHookReset(t *testing.T) {
+	h := &CounterHook{}
+	h.ConnOpened(nil)
+	h.TxBegan(nil)
+	h.Execed(time.Millisecond, "UPDATE t SET a=1", nil)
+
+	want := Stats{
+		OpenConns: 1, TotalConns: 1, OpenTxs: 1, TotalTxs: 1, Execs: 1,
+		ExecLatency: Latency{Count: 1, Total: time.Millisecond, Min: time.Millisecond, Max: time.Millisecond},
+	}
+	if got := h.Reset(); got != want {
+		t.Errorf("Expected Reset to return %+v, got %+v", want, got)
+	}
+	want = Stats{OpenConns: 1, OpenTxs: 1}
+	if got := h.Snapshot(); got != want {
+		t.Errorf("Expected Reset to zero everything but the gauges, got %+v", got)
+	}
+
+	h.TxCommitted(nil)
+	want = Stats{OpenConns: 1, CommittedTxs: 1}
+	if got := h.Snapshot(); got != want {
+		t.Errorf("Expected %+v after Reset, got %+v", want, got)
+	}
+}
+
+func TestCounterHookResetDuringUpdates(t *testing.T) {
+	h := &CounterHook{}
+	const workers, execs = 4, 1000
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < execs; j++ {
+				h.Execed(time.Millisecond, "UPDATE t SET a=1", nil)
+			}
+		}()
+	}
+	total := 0
+	for i := 0; i < 10; i++ {
+		total += h.Reset().Execs
+	}
+	wg.Wait()
+	total += h.Reset().Execs
+
+	// Updates that race with Reset may be missed, but none can be counted twice.
+	if total > workers*execs {
+		t.Errorf("Expected at most %d execs across Resets, got %d", workers*execs, total)
+	}
+}
+
+func TestCounterHookLatency(t *testing.T) {
+	h := &CounterHook{}
+	ctx := context.Background()
+	for _, d := range []time.Duration{3 * time.Millisecond, time.Millisecond, 8 * time.Millisecond} {
+		h.Queried(d, "SELECT 1", nil)
+	}
+	h.Execed(0, "UPDATE t SET a=1", anErr)
+	h.TxEnded(ctx, TxSummary{Duration: time.Second, Committed: true}, nil)
+	h.ConnOpenedWithDuration(ctx, 50*time.Millisecond, anErr)
+
+	want := Latency{Count: 3, Total: 12 * time.Millisecond, Min: time.Millisecond, Max: 8 * time.Millisecond}
+	if got := h.QueryLatency(); got != want || got.Mean() != 4*time.Millisecond {
+		t.Errorf("Expected QueryLatency %+v with mean 4ms, got %+v with mean %v", want, got, got.Mean())
+	}
+	if got := h.ExecLatency(); got != (Latency{Count: 1}) {
+		t.Errorf("Expected a single zero exec duration, got %+v", got)
+	}
+	if got := h.TxLatency(); got.Count != 1 || got.Total != time.Second {
+		t.Errorf("Expected a single 1s transaction, got %+v", got)
+	}
+	if got := h.ConnOpenLatency(); got.Count != 1 || got.Max != 50*time.Millisecond {
+		t.Errorf("Expected a single 50ms connection open, got %+v", got)
+	}
+	if got := (Latency{}).Mean(); got != 0 {
+		t.Errorf("Expected the mean of no durations to be 0, got %v", got)
+	}
+}
+
+func TestCounterHookLatencyConcurrent(t *testing.T) {
+	h := &CounterHook{}
+	const workers = 8
+	var wg sync.WaitGroup
+	for i := 1; i <= workers; i++ {
+		wg.Add(1)
+		go func(d time.Duration) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				h.Queried(d, "SELECT 1", nil)
+			}
+		}(time.Duration(i) * time.Millisecond)
+	}
+	wg.Wait()
+
+	want := Latency{Count: workers * 100, Total: 3600 * time.Millisecond, Min: time.Millisecond, Max: workers * time.Millisecond}
+	if got := h.QueryLatency(); got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
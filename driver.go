@@ -1,8 +1,10 @@
 package dbstats
 
 import (
+	"context"
 	"database/sql/driver"
-	"io"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -11,6 +13,11 @@ import (
 // function.
 type OpenFunc func(name string) (driver.Conn, error)
 
+// Open implements driver.Driver by calling f(name).
+func (f OpenFunc) Open(name string) (driver.Conn, error) {
+	return f(name)
+}
+
 // Hook is an interface through which database events can be received. A Hook may received
 // multiple events concurrently. Each function's last argument is of type error which will
 // contain an error encountered while trying to perform the action. The one exception is
@@ -28,249 +35,621 @@ type Hook interface {
 	RowIterated(err error)
 }
 
+// ContextHook is an optional interface that a Hook may implement in order to receive the
+// context.Context associated with each event, for example to read request scoped values
+// such as trace IDs. When a Hook implements ContextHook these methods are called in
+// place of the matching Hook methods. ctx is the context passed to the driver method
+// that caused the event. Events that come from a method without a context get the
+// context of the operation they belong to: TxCommittedContext and TxRolledbackContext
+// receive the context the transaction was begun with and RowIteratedContext the
+// context the query was run with. Otherwise context.Background() is used.
+type ContextHook interface {
+	ConnOpenedContext(ctx context.Context, err error)
+	ConnClosedContext(ctx context.Context, err error)
+	StmtPreparedContext(ctx context.Context, query string, err error)
+	StmtClosedContext(ctx context.Context, err error)
+	TxBeganContext(ctx context.Context, err error)
+	TxCommittedContext(ctx context.Context, err error)
+	TxRolledbackContext(ctx context.Context, err error)
+	QueriedContext(ctx context.Context, d time.Duration, query string, err error)
+	ExecedContext(ctx context.Context, d time.Duration, query string, err error)
+	RowIteratedContext(ctx context.Context, err error)
+}
+
+// ArgsHook is an optional interface that a Hook may implement in order to receive the
+// arguments of each query and exec. QueriedArgs and ExecedArgs are called right after
+// Queried and Execed. Arguments passed without a name or ordinal, as with the
+// driver.Queryer and driver.Execer interfaces, are given their ordinal position. If the
+// driver has a RedactPolicy the arguments it masks are replaced before being passed
+// to the hook. args must not be modified or retained after the call returns.
+type ArgsHook interface {
+	QueriedArgs(ctx context.Context, d time.Duration, query string, args []driver.NamedValue, err error)
+	ExecedArgs(ctx context.Context, d time.Duration, query string, args []driver.NamedValue, err error)
+}
+
+// ExecResult holds the details of the driver.Result of an exec. RowsAffectedErr and
+// LastInsertIdErr hold the errors returned by the driver.Result methods, for example
+// from a driver that does not support LastInsertId.
+type ExecResult struct {
+	RowsAffected    int64
+	RowsAffectedErr error
+	LastInsertId    int64
+	LastInsertIdErr error
+}
+
+// ExecResultHook is an optional interface that a Hook may implement in order to
+// receive the result of each successful exec, for example to track how many rows each
+// UPDATE or DELETE touched. ExecResulted is called right after Execed.
+type ExecResultHook interface {
+	ExecResulted(ctx context.Context, query string, r ExecResult)
+}
+
+// TxOptionsHook is an optional interface that a Hook may implement in order to receive
+// the options each transaction was started with. TxBeganWithOptions is called right
+// after TxBegan. For transactions started with Begin the options are the zero value,
+// which is the driver's default isolation level and read-write.
+type TxOptionsHook interface {
+	TxBeganWithOptions(ctx context.Context, opts driver.TxOptions, err error)
+}
+
+// PingHook is an optional interface that a Hook may implement in order to be notified
+// each time a connection is pinged, for example by db.PingContext. Pinged is only
+// called for drivers that implement driver.Pinger.
+type PingHook interface {
+	Pinged(ctx context.Context, d time.Duration, err error)
+}
+
+// SessionHook is an optional interface that a Hook may implement in order to be
+// notified when database/sql resets the session of a connection before reusing it and
+// when it discards a connection that the driver reports as no longer valid. SessionReset
+// is only called for drivers that implement driver.SessionResetter and ConnInvalidated
+// is only called for drivers that implement driver.Validator.
+type SessionHook interface {
+	SessionReset(ctx context.Context, d time.Duration, err error)
+	ConnInvalidated(ctx context.Context)
+}
+
+// ResultSetHook is an optional interface that a Hook may implement in order to be
+// notified each time the rows of a query's result set are finished with, either
+// because database/sql moved on to the next result set or because the rows were
+// closed. index is the zero based position of the result set within the query's
+// results and rows is the number of rows iterated from it. The number of result sets
+// a query returned is one more than the largest index reported for it.
+type ResultSetHook interface {
+	ResultSetDone(ctx context.Context, query string, index int, rows int)
+}
+
+// RowsHook is an optional interface that a Hook may implement in order to be notified
+// when the rows returned by a query are closed. Unlike the duration passed to Queried,
+// d covers the time from the start of the query until the rows were closed, including
+// the time spent fetching rows. rows is the number of rows iterated across all result
+// sets and err is the error returned by Close.
+type RowsHook interface {
+	RowsClosed(ctx context.Context, d time.Duration, query string, rows int, err error)
+}
+
+// ConnOpenHook is an optional interface that a Hook may implement in order to be told
+// how long each attempt to open a connection took, including dialing and
+// authentication. It is called just after ConnOpened, for failed opens as well as
+// successful ones.
+type ConnOpenHook interface {
+	ConnOpenedWithDuration(ctx context.Context, d time.Duration, err error)
+}
+
+// BadConnHook is an optional interface that a Hook may implement in order to be
+// notified when a query, exec, prepare, begin or ping fails with driver.ErrBadConn.
+// query is empty for kinds of operation that do not have one. BadConn is called just
+// before the failure is reported as usual, to Queried or Execed for example.
+//
+// Outside of a transaction database/sql retries such operations, first on pooled
+// connections and finally on a new one, and only returns driver.ErrBadConn to the
+// application when every attempt fails. The driver cannot tell which attempt is the
+// last, so hooks that count errors the application saw, like CounterHook, should count
+// driver.ErrBadConn failures separately, here, rather than as errors. Operations in a
+// transaction or run through a sql.Conn are not retried.
+//
+// Operations that fail with driver.ErrSkip, which tells database/sql to fall back to
+// preparing a statement, are not reported at all.
+type BadConnHook interface {
+	BadConn(ctx context.Context, kind OpKind, query string)
+}
+
+// ConnStats summarises the life of a connection.
+type ConnStats struct {
+	ID      uint64        // the connection's ID, as returned by ConnID
+	Age     time.Duration // the time from the connection being opened until it was closed
+	Queries int           // the number of queries run on the connection, including through statements
+	Execs   int           // the number of execs run on the connection, including through statements
+}
+
+// ConnStatsHook is an optional interface that a Hook may implement in order to be
+// told about a connection's life when it is closed. It is called just after
+// ConnClosed, err is the error returned by Close.
+type ConnStatsHook interface {
+	ConnClosedStats(ctx context.Context, stats ConnStats, err error)
+}
+
+// TxSummary summarises a transaction once it has been committed or rolled back.
+type TxSummary struct {
+	Duration     time.Duration // the time from the start of Begin until Commit or Rollback returned
+	Queries      int           // the number of queries run in the transaction
+	Execs        int           // the number of execs run in the transaction
+	RowsAffected int64         // the total rows affected by the transaction's successful execs
+	Committed    bool          // true if the transaction ended with Commit, false for Rollback
+}
+
+// TxSummaryHook is an optional interface that a Hook may implement in order to be
+// given a summary of each transaction when it ends. It is called just after
+// TxCommitted or TxRolledback, with the same error. RowsAffected only counts execs for
+// which the driver's Result reports rows affected.
+type TxSummaryHook interface {
+	TxEnded(ctx context.Context, summary TxSummary, err error)
+}
+
+// Finisher is returned by the StartHook methods in order to be told when the started
+// operation has finished. It is called exactly once, with the error the operation
+// finished with.
+type Finisher func(err error)
+
+// StartHook is an optional interface that a Hook may implement in order to be notified
+// before an operation is passed to the wrapped driver, for example to track which
+// operations are in flight or to start a tracing span. Each method may return a
+// Finisher, or nil if it does not need to know when the operation finishes.
+//
+// The Finishers returned by ConnOpenStarted, QueryStarted and ExecStarted are called
+// when the wrapped call returns, just before ConnOpened, Queried and Execed. The
+// Finisher returned by TxStarted is called when the transaction ends: with the error
+// from the wrapped Begin if it fails, otherwise with the error from Commit or Rollback.
+// As they are called before it is known whether database/sql will retry the operation,
+// Finishers are also called with driver.ErrSkip and driver.ErrBadConn, see BadConnHook.
+type StartHook interface {
+	ConnOpenStarted(ctx context.Context) Finisher
+	QueryStarted(ctx context.Context, query string) Finisher
+	ExecStarted(ctx context.Context, query string) Finisher
+	TxStarted(ctx context.Context) Finisher
+}
+
+// finishers holds the Finishers returned for a single operation.
+type finishers []Finisher
+
+func (fs finishers) finish(err error) {
+	for _, f := range fs {
+		f(err)
+	}
+}
+
 type Driver interface {
 	driver.Driver
+	driver.DriverContext
 
 	// AddHook will add a Hook to be called when various database events occurs. AddHook
-	// should be called before any database activity happens as there is no gaurantee that
-	// locking will occur between addining and using Hooks.
+	// may be called at any time, operations that are already in progress may or may not
+	// be reported to h. If h also implements any of the optional hook interfaces
+	// (ContextHook or TxOptionsHook for example) those events will also be passed to it.
 	AddHook(h Hook)
+
+	// RemoveHook removes a Hook added with AddHook. Like AddHook it may be called at any
+	// time, and operations already in progress may still be reported to h. Hooks are
+	// compared with ==, so h must be of a comparable type, a pointer for example.
+	RemoveHook(h Hook)
+
+	// SetRedactPolicy sets the policy used to mask query arguments before they are
+	// passed to ArgsHooks. By default arguments are passed unmasked. SetRedactPolicy
+	// should be called before any database activity happens.
+	SetRedactPolicy(p RedactPolicy)
+
+	// AddInterceptor adds an Interceptor to the end of the driver's interceptor chain.
+	// Hooks are always called from the start of the chain, before any interceptors
+	// added here. AddInterceptor should be called before any database activity
+	// happens.
+	AddInterceptor(i Interceptor)
 }
 
 func New(open OpenFunc) Driver {
-	return &statsDriver{open: open}
+	return newStatsDriver(open)
+}
+
+// newStatsDriver returns a statsDriver whose interceptor chain holds just the
+// interceptor that calls its hooks.
+func newStatsDriver(open OpenFunc) *statsDriver {
+	s := &statsDriver{open: open}
+	s.AddInterceptor(s.hookInterceptor)
+	return s
 }
 
 type statsDriver struct {
-	open  OpenFunc
-	hooks []Hook
+	open          OpenFunc
+	openConnector func(name string) (driver.Connector, error) // nil if the wrapped driver is not a driver.DriverContext
+
+	hookMu  sync.Mutex   // held while replacing hookSet
+	hookSet atomic.Value // the current *hookSet, never modified once stored
+
+	redact RedactPolicy
+
+	interceptors []Interceptor
+	handler      Handler // interceptors chained in front of perform
 }
 
 func (s *statsDriver) Open(name string) (driver.Conn, error) {
+	ctx := context.Background()
+	fs := s.ConnOpenStarted(ctx)
+	start := time.Now()
 	c, err := s.open(name)
-	s.ConnOpened(err)
+	fs.finish(err)
+	return s.opened(ctx, start, c, err)
+}
+
+// OpenConnector implements driver.DriverContext. If the wrapped driver implements
+// driver.DriverContext its connector is wrapped, otherwise the returned Connector
+// opens connections with the OpenFunc the driver was created with.
+func (s *statsDriver) OpenConnector(name string) (driver.Connector, error) {
+	if s.openConnector == nil {
+		return &statsConnector{d: s, wrapped: dsnConnector{dsn: name, open: s.open}}, nil
+	}
+	c, err := s.openConnector(name)
 	if err != nil {
+		return nil, err
+	}
+	return wrapConnector(&statsConnector{d: s, wrapped: c}), nil
+}
+
+// opened reports a finished connection open to the hooks and wraps c so that its
+// activity is reported as well.
+func (s *statsDriver) opened(ctx context.Context, start time.Time, c driver.Conn, err error) (driver.Conn, error) {
+	now := time.Now()
+	if err != nil {
+		s.ConnOpened(ctx, err)
+		s.ConnOpenedWithDuration(ctx, now.Sub(start), err)
 		return c, err
 	}
-	statc := &statsConn{d: s, wrapped: c}
-	q, isQ := c.(driver.Queryer)
-	e, isE := c.(driver.Execer)
-	if isE && isQ {
-		return &statsExecerQueryer{
-			statsConn:    statc,
-			statsQueryer: &statsQueryer{statsConn: statc, wrapped: q},
-			statsExecer:  &statsExecer{statsConn: statc, wrapped: e},
-		}, nil
-	} else if isQ {
-		return &statsQueryer{statsConn: statc, wrapped: q}, nil
-	} else if isE {
-		return &statsExecer{statsConn: statc, wrapped: e}, nil
+	sc := &statsConn{d: s, wrapped: c, id: atomic.AddUint64(&lastConnID, 1), opened: now}
+	ctx = sc.context(ctx)
+	s.ConnOpened(ctx, nil)
+	s.ConnOpenedWithDuration(ctx, now.Sub(start), nil)
+	return wrapConn(sc), nil
+}
+
+// queried reports a finished query to the hooks and wraps r so that its rows are
+// reported as well.
+func (s *statsDriver) queried(ctx context.Context, start time.Time, query string, args []driver.NamedValue, r driver.Rows, err error) (driver.Rows, error) {
+	d := time.Now().Sub(start)
+	s.Queried(ctx, d, query, err)
+	s.QueriedArgs(ctx, d, query, args, err)
+	if err != nil {
+		return r, err
+	}
+	return wrapRows(&statsRows{d: s, ctx: ctx, start: start, query: query, wrapped: r}), nil
+}
+
+// execed reports a finished exec to the hooks.
+func (s *statsDriver) execed(ctx context.Context, start time.Time, query string, args []driver.NamedValue, r driver.Result, err error) {
+	d := time.Now().Sub(start)
+	s.Execed(ctx, d, query, err)
+	s.ExecedArgs(ctx, d, query, args, err)
+	if err == nil && len(s.loadHooks().resultHooks) > 0 {
+		var er ExecResult
+		er.RowsAffected, er.RowsAffectedErr = r.RowsAffected()
+		er.LastInsertId, er.LastInsertIdErr = r.LastInsertId()
+		s.ExecResulted(ctx, query, er)
 	}
-	return statc, nil
+}
+
+func (s *statsDriver) SetRedactPolicy(p RedactPolicy) {
+	s.redact = p
 }
 
 func (s *statsDriver) AddHook(h Hook) {
-	s.hooks = append(s.hooks, h)
+	s.hookMu.Lock()
+	defer s.hookMu.Unlock()
+	old := s.loadHooks().all
+	all := make([]Hook, len(old), len(old)+1)
+	copy(all, old)
+	s.hookSet.Store(newHookSet(append(all, h)))
+}
+
+func (s *statsDriver) RemoveHook(h Hook) {
+	s.hookMu.Lock()
+	defer s.hookMu.Unlock()
+	var all []Hook
+	for _, ah := range s.loadHooks().all {
+		if ah != h {
+			all = append(all, ah)
+		}
+	}
+	s.hookSet.Store(newHookSet(all))
+}
+
+// loadHooks returns the driver's current hooks.
+func (s *statsDriver) loadHooks() *hookSet {
+	if hs, ok := s.hookSet.Load().(*hookSet); ok {
+		return hs
+	}
+	return &hookSet{}
+}
+
+// hookSet holds the hooks added to a driver, sorted by the optional interfaces they
+// implement. A hookSet is never modified once it is in use, AddHook and RemoveHook
+// replace it instead, so events can be dispatched without locking.
+type hookSet struct {
+	all   []Hook // every hook, in the order they were added
+	hooks []Hook
+
+	// Hooks that implement ContextHook are stored in ctxHooks rather than hooks.
+	ctxHooks       []ContextHook
+	argsHooks      []ArgsHook
+	resultHooks    []ExecResultHook
+	startHooks     []StartHook
+	txOptionsHooks []TxOptionsHook
+	pingHooks      []PingHook
+	sessionHooks   []SessionHook
+	resultSetHooks []ResultSetHook
+	rowsHooks      []RowsHook
+	connHooks      []ConnStatsHook
+	txHooks        []TxSummaryHook
+	openHooks      []ConnOpenHook
+	badConnHooks   []BadConnHook
 }
-func (s *statsDriver) ConnOpened(err error) {
-	for _, h := range s.hooks {
+
+func newHookSet(all []Hook) *hookSet {
+	hs := &hookSet{all: all}
+	for _, h := range all {
+		if ch, ok := h.(ContextHook); ok {
+			hs.ctxHooks = append(hs.ctxHooks, ch)
+		} else {
+			hs.hooks = append(hs.hooks, h)
+		}
+		if ah, ok := h.(ArgsHook); ok {
+			hs.argsHooks = append(hs.argsHooks, ah)
+		}
+		if rh, ok := h.(ExecResultHook); ok {
+			hs.resultHooks = append(hs.resultHooks, rh)
+		}
+		if sh, ok := h.(StartHook); ok {
+			hs.startHooks = append(hs.startHooks, sh)
+		}
+		if th, ok := h.(TxOptionsHook); ok {
+			hs.txOptionsHooks = append(hs.txOptionsHooks, th)
+		}
+		if ph, ok := h.(PingHook); ok {
+			hs.pingHooks = append(hs.pingHooks, ph)
+		}
+		if sh, ok := h.(SessionHook); ok {
+			hs.sessionHooks = append(hs.sessionHooks, sh)
+		}
+		if rh, ok := h.(ResultSetHook); ok {
+			hs.resultSetHooks = append(hs.resultSetHooks, rh)
+		}
+		if rh, ok := h.(RowsHook); ok {
+			hs.rowsHooks = append(hs.rowsHooks, rh)
+		}
+		if ch, ok := h.(ConnStatsHook); ok {
+			hs.connHooks = append(hs.connHooks, ch)
+		}
+		if th, ok := h.(TxSummaryHook); ok {
+			hs.txHooks = append(hs.txHooks, th)
+		}
+		if oh, ok := h.(ConnOpenHook); ok {
+			hs.openHooks = append(hs.openHooks, oh)
+		}
+		if bh, ok := h.(BadConnHook); ok {
+			hs.badConnHooks = append(hs.badConnHooks, bh)
+		}
+	}
+	return hs
+}
+func (s *statsDriver) ConnOpenStarted(ctx context.Context) finishers {
+	hs := s.loadHooks()
+	var fs finishers
+	for _, h := range hs.startHooks {
+		if f := h.ConnOpenStarted(ctx); f != nil {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+func (s *statsDriver) QueryStarted(ctx context.Context, query string) finishers {
+	hs := s.loadHooks()
+	var fs finishers
+	for _, h := range hs.startHooks {
+		if f := h.QueryStarted(ctx, query); f != nil {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+func (s *statsDriver) ExecStarted(ctx context.Context, query string) finishers {
+	hs := s.loadHooks()
+	var fs finishers
+	for _, h := range hs.startHooks {
+		if f := h.ExecStarted(ctx, query); f != nil {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+func (s *statsDriver) TxStarted(ctx context.Context) finishers {
+	hs := s.loadHooks()
+	var fs finishers
+	for _, h := range hs.startHooks {
+		if f := h.TxStarted(ctx); f != nil {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+func (s *statsDriver) ConnOpened(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.ConnOpened(err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.ConnOpenedContext(ctx, err)
+	}
+}
+func (s *statsDriver) ConnOpenedWithDuration(ctx context.Context, d time.Duration, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.openHooks {
+		h.ConnOpenedWithDuration(ctx, d, err)
+	}
 }
-func (s *statsDriver) ConnClosed(err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) ConnClosed(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.ConnClosed(err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.ConnClosedContext(ctx, err)
+	}
 }
-func (s *statsDriver) StmtPrepared(query string, err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) StmtPrepared(ctx context.Context, query string, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.StmtPrepared(query, err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.StmtPreparedContext(ctx, query, err)
+	}
 }
-func (s *statsDriver) StmtClosed(err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) StmtClosed(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.StmtClosed(err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.StmtClosedContext(ctx, err)
+	}
 }
-func (s *statsDriver) TxBegan(err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) TxBegan(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.TxBegan(err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.TxBeganContext(ctx, err)
+	}
+}
+func (s *statsDriver) TxBeganWithOptions(ctx context.Context, opts driver.TxOptions, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.txOptionsHooks {
+		h.TxBeganWithOptions(ctx, opts, err)
+	}
 }
-func (s *statsDriver) TxCommitted(err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) TxCommitted(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.TxCommitted(err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.TxCommittedContext(ctx, err)
+	}
 }
-func (s *statsDriver) TxRolledback(err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) TxRolledback(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.TxRolledback(err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.TxRolledbackContext(ctx, err)
+	}
 }
-func (s *statsDriver) Queried(d time.Duration, query string, err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) Queried(ctx context.Context, d time.Duration, query string, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.Queried(d, query, err)
 	}
+	for _, h := range hs.ctxHooks {
+		h.QueriedContext(ctx, d, query, err)
+	}
 }
-func (s *statsDriver) Execed(d time.Duration, query string, err error) {
-	for _, h := range s.hooks {
+func (s *statsDriver) Execed(ctx context.Context, d time.Duration, query string, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
 		h.Execed(d, query, err)
 	}
-}
-func (s *statsDriver) RowIterated(err error) {
-	for _, h := range s.hooks {
-		h.RowIterated(err)
+	for _, h := range hs.ctxHooks {
+		h.ExecedContext(ctx, d, query, err)
 	}
 }
-
-type statsConn struct {
-	d       *statsDriver // the driver in which to store stats
-	wrapped driver.Conn  // the wrapped connection
-}
-
-func (c *statsConn) Prepare(query string) (driver.Stmt, error) {
-	s, err := c.wrapped.Prepare(query)
-	c.d.StmtPrepared(query, err)
-	if err == nil {
-		cc, isCc := s.(driver.ColumnConverter)
-		if isCc {
-			s = &statsColumnConverter{
-				statsStmt: &statsStmt{d: c.d, wrapped: s, query: query},
-				wrapped:   cc,
-			}
-		} else {
-			s = &statsStmt{d: c.d, wrapped: s, query: query}
-		}
+func (s *statsDriver) QueriedArgs(ctx context.Context, d time.Duration, query string, args []driver.NamedValue, err error) {
+	hs := s.loadHooks()
+	if len(hs.argsHooks) == 0 {
+		return
 	}
-	return s, err
-}
-
-func (c *statsConn) Close() error {
-	err := c.wrapped.Close()
-	c.d.ConnClosed(err)
-	return err
-}
-
-func (c *statsConn) Begin() (driver.Tx, error) {
-	tx, err := c.wrapped.Begin()
-	c.d.TxBegan(err)
-	if err == nil {
-		tx = &statsTx{d: c.d, wrapped: tx}
+	args = s.redact.redact(query, args)
+	for _, h := range hs.argsHooks {
+		h.QueriedArgs(ctx, d, query, args, err)
 	}
-	return tx, err
 }
-
-type statsQueryer struct {
-	*statsConn
-	wrapped driver.Queryer
-}
-
-func (q *statsQueryer) Query(query string, args []driver.Value) (driver.Rows, error) {
-	start := time.Now()
-	r, err := q.wrapped.Query(query, args)
-	dur := time.Now().Sub(start)
-	q.statsConn.d.Queried(dur, query, err)
-	if err == nil {
-		r = &statsRows{d: q.statsConn.d, wrapped: r}
+func (s *statsDriver) ExecedArgs(ctx context.Context, d time.Duration, query string, args []driver.NamedValue, err error) {
+	hs := s.loadHooks()
+	if len(hs.argsHooks) == 0 {
+		return
+	}
+	args = s.redact.redact(query, args)
+	for _, h := range hs.argsHooks {
+		h.ExecedArgs(ctx, d, query, args, err)
 	}
-	return r, err
-}
-
-type statsExecer struct {
-	*statsConn
-	wrapped driver.Execer
-}
-
-func (e *statsExecer) Exec(query string, args []driver.Value) (driver.Result, error) {
-	start := time.Now()
-	r, err := e.wrapped.Exec(query, args)
-	dur := time.Now().Sub(start)
-	e.statsConn.d.Execed(dur, query, err)
-	return r, err
-}
-
-type statsExecerQueryer struct {
-	*statsConn
-	*statsQueryer
-	*statsExecer
-}
-
-type statsStmt struct {
-	d       *statsDriver
-	wrapped driver.Stmt
-	query   string
-}
-
-type statsColumnConverter struct {
-	*statsStmt
-	wrapped driver.ColumnConverter
-}
-
-func (vc *statsColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return vc.wrapped.ColumnConverter(idx)
-}
-
-func (s *statsStmt) Close() error {
-	err := s.wrapped.Close()
-	s.d.StmtClosed(err)
-	return err
 }
-
-func (s *statsStmt) NumInput() int {
-	return s.wrapped.NumInput()
+func (s *statsDriver) ExecResulted(ctx context.Context, query string, r ExecResult) {
+	hs := s.loadHooks()
+	for _, h := range hs.resultHooks {
+		h.ExecResulted(ctx, query, r)
+	}
 }
-
-func (s *statsStmt) Exec(args []driver.Value) (driver.Result, error) {
-	start := time.Now()
-	r, err := s.wrapped.Exec(args)
-	dur := time.Now().Sub(start)
-	s.d.Execed(dur, s.query, err)
-	return r, err
+func (s *statsDriver) Pinged(ctx context.Context, d time.Duration, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.pingHooks {
+		h.Pinged(ctx, d, err)
+	}
 }
-
-func (s *statsStmt) Query(args []driver.Value) (driver.Rows, error) {
-	start := time.Now()
-	r, err := s.wrapped.Query(args)
-	dur := time.Now().Sub(start)
-	s.d.Queried(dur, s.query, err)
-	if err == nil {
-		r = &statsRows{d: s.d, wrapped: r}
+func (s *statsDriver) SessionReset(ctx context.Context, d time.Duration, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.sessionHooks {
+		h.SessionReset(ctx, d, err)
 	}
-	return r, err
 }
-
-type statsRows struct {
-	d       *statsDriver
-	wrapped driver.Rows
+func (s *statsDriver) ConnInvalidated(ctx context.Context) {
+	hs := s.loadHooks()
+	for _, h := range hs.sessionHooks {
+		h.ConnInvalidated(ctx)
+	}
 }
-
-func (r *statsRows) Columns() []string {
-	return r.wrapped.Columns()
+func (s *statsDriver) ResultSetDone(ctx context.Context, query string, index int, rows int) {
+	hs := s.loadHooks()
+	for _, h := range hs.resultSetHooks {
+		h.ResultSetDone(ctx, query, index, rows)
+	}
 }
-func (r *statsRows) Close() error {
-	return r.wrapped.Close()
+func (s *statsDriver) RowsClosed(ctx context.Context, d time.Duration, query string, rows int, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.rowsHooks {
+		h.RowsClosed(ctx, d, query, rows, err)
+	}
 }
-func (r *statsRows) Next(dest []driver.Value) error {
-	err := r.wrapped.Next(dest)
-	if err != io.EOF {
-		r.d.RowIterated(err)
+func (s *statsDriver) ConnClosedStats(ctx context.Context, stats ConnStats, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.connHooks {
+		h.ConnClosedStats(ctx, stats, err)
 	}
-	return err
 }
-
-type statsTx struct {
-	d       *statsDriver
-	wrapped driver.Tx
+func (s *statsDriver) TxEnded(ctx context.Context, summary TxSummary, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.txHooks {
+		h.TxEnded(ctx, summary, err)
+	}
 }
-
-func (t *statsTx) Commit() error {
-	err := t.wrapped.Commit()
-	t.d.TxCommitted(err)
-	return err
+func (s *statsDriver) BadConn(ctx context.Context, kind OpKind, query string) {
+	hs := s.loadHooks()
+	for _, h := range hs.badConnHooks {
+		h.BadConn(ctx, kind, query)
+	}
 }
-
-func (t *statsTx) Rollback() error {
-	err := t.wrapped.Rollback()
-	t.d.TxRolledback(err)
-	return err
+func (s *statsDriver) RowIterated(ctx context.Context, err error) {
+	hs := s.loadHooks()
+	for _, h := range hs.hooks {
+		h.RowIterated(err)
+	}
+	for _, h := range hs.ctxHooks {
+		h.RowIteratedContext(ctx, err)
+	}
 }
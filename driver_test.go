@@ -1,10 +1,14 @@
 package dbstats
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"io"
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -15,17 +19,34 @@ var (
 	queryer              *fakeDriver
 	execer               *fakeDriver
 	execerQueryer        *fakeDriver
+	contextConn          *fakeDriver
 	stats                Driver
 	queryerStats         Driver
 	execerStats          Driver
 	execerQueryerStats   Driver
+	contextStats         Driver
 	queryerCalled        bool
 	execerCalled         bool
 	useColumnConverter   bool
 	columnCoverterCalled bool
+	queryerCtxCalled     bool
+	execerCtxCalled      bool
+	beginTxOpts          *driver.TxOptions
+	prepareCtxCalled     bool
+	stmtQueryCtxCalled   bool
+	stmtExecCtxCalled    bool
+	useNamedValueChecker bool
+	connCheckerCalled    bool
+	stmtCheckerCalled    bool
+	useColumnTypeRows    bool
+	useMultiResultRows   bool
 
 	connOpenErr  error
 	connCloseErr error
+	pingErr      error
+	pingCalled   bool
+	resetErr     error
+	connInvalid  bool
 )
 
 func init() {
@@ -34,18 +55,22 @@ func init() {
 	queryer = &fakeDriver{isQueryer: true}
 	execer = &fakeDriver{isExecer: true}
 	execerQueryer = &fakeDriver{isQueryer: true, isExecer: true}
+	contextConn = &fakeDriver{isContext: true}
 	stats = New(fake.Open)
 	queryerStats = New(queryer.Open)
 	execerStats = New(execer.Open)
 	execerQueryerStats = New(execerQueryer.Open)
+	contextStats = New(contextConn.Open)
 	stats.AddHook(hook)
 	queryerStats.AddHook(hook)
 	execerStats.AddHook(hook)
 	execerQueryerStats.AddHook(hook)
+	contextStats.AddHook(hook)
 	sql.Register("fakeStats", stats)
 	sql.Register("fakeQueryerStats", queryerStats)
 	sql.Register("fakeExecerStats", execerStats)
 	sql.Register("fakeExecerQueryerStats", execerQueryerStats)
+	sql.Register("fakeContextStats", contextStats)
 }
 
 func reset() {
@@ -54,8 +79,23 @@ func reset() {
 	execerCalled = false
 	useColumnConverter = false
 	columnCoverterCalled = false
+	queryerCtxCalled = false
+	execerCtxCalled = false
+	beginTxOpts = nil
+	prepareCtxCalled = false
+	stmtQueryCtxCalled = false
+	stmtExecCtxCalled = false
+	useNamedValueChecker = false
+	connCheckerCalled = false
+	stmtCheckerCalled = false
+	useColumnTypeRows = false
+	useMultiResultRows = false
 	connOpenErr = nil
 	connCloseErr = nil
+	pingErr = nil
+	pingCalled = false
+	resetErr = nil
+	connInvalid = false
 	hook.reset()
 }
 
@@ -63,6 +103,7 @@ type fakeDriver struct {
 	openNames []string
 	isQueryer bool
 	isExecer  bool
+	isContext bool
 }
 
 func (d *fakeDriver) Open(name string) (driver.Conn, error) {
@@ -70,7 +111,9 @@ func (d *fakeDriver) Open(name string) (driver.Conn, error) {
 	if connOpenErr != nil {
 		return nil, connOpenErr
 	}
-	if d.isExecer && d.isQueryer {
+	if d.isContext {
+		return &fakeContextConn{}, nil
+	} else if d.isExecer && d.isQueryer {
 		return &fakeExecerQueryer{}, nil
 	} else if d.isQueryer {
 		return &fakeQueryer{}, nil
@@ -83,6 +126,9 @@ func (d *fakeDriver) Open(name string) (driver.Conn, error) {
 type fakeConn struct{}
 
 func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	if useNamedValueChecker {
+		return &fakeNamedValueCheckerStmt{}, nil
+	}
 	if useColumnConverter {
 		return &fakeColumnCoverter{}, nil
 	}
@@ -120,6 +166,59 @@ func (e *fakeExecerQueryer) Exec(query string, args []driver.Value) (driver.Resu
 	return &fakeResult{}, nil
 }
 
+type fakeContextConn struct{ fakeConn }
+
+func (c *fakeContextConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryerCtxCalled = true
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if useColumnTypeRows {
+		return &fakeColumnTypeRows{}, nil
+	}
+	if useMultiResultRows {
+		return &fakeMultiResultRows{sets: []int{1, 2}}, nil
+	}
+	return &fakeRows{}, nil
+}
+func (c *fakeContextConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execerCtxCalled = true
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &fakeResult{}, nil
+}
+
+func (c *fakeContextConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	beginTxOpts = &opts
+	return &fakeTx{}, nil
+}
+
+func (c *fakeContextConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	prepareCtxCalled = true
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &fakeContextStmt{}, nil
+}
+
+func (c *fakeContextConn) Ping(ctx context.Context) error {
+	pingCalled = true
+	return pingErr
+}
+
+func (c *fakeContextConn) ResetSession(ctx context.Context) error {
+	return resetErr
+}
+func (c *fakeContextConn) IsValid() bool {
+	return !connInvalid
+}
+
+func (c *fakeContextConn) CheckNamedValue(nv *driver.NamedValue) error {
+	connCheckerCalled = true
+	return checkFakeUUID(nv)
+}
+
 type fakeTx struct{}
 
 func (t *fakeTx) Commit() error {
@@ -144,6 +243,23 @@ func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
 	return &fakeRows{}, nil
 }
 
+type fakeContextStmt struct{ fakeStmt }
+
+func (s *fakeContextStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	stmtExecCtxCalled = true
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &fakeResult{}, nil
+}
+func (s *fakeContextStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	stmtQueryCtxCalled = true
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &fakeRows{}, nil
+}
+
 type passthroughValueConverter struct{}
 
 func (vc passthroughValueConverter) ConvertValue(v interface{}) (driver.Value, error) {
@@ -157,6 +273,24 @@ func (vc *fakeColumnCoverter) ColumnConverter(idx int) driver.ValueConverter {
 	return passthroughValueConverter{}
 }
 
+// fakeUUID is an argument type that database/sql's default conversion rejects.
+type fakeUUID [16]byte
+
+func checkFakeUUID(nv *driver.NamedValue) error {
+	if u, ok := nv.Value.(fakeUUID); ok {
+		nv.Value = u[:]
+		return nil
+	}
+	return driver.ErrSkip
+}
+
+type fakeNamedValueCheckerStmt struct{ fakeStmt }
+
+func (s *fakeNamedValueCheckerStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	stmtCheckerCalled = true
+	return checkFakeUUID(nv)
+}
+
 type fakeRows struct {
 	rows int
 }
@@ -198,6 +332,33 @@ type fakeHook struct {
 	execedCount       int
 	rowIteratedCount  int
 	numErr            int
+
+	txOpts           []driver.TxOptions
+	pingErrs         []error
+	resetErrs        []error
+	invalidatedCount int
+	resultSets       []resultSetEvent
+	queriedArgs      [][]driver.NamedValue
+	execedArgs       [][]driver.NamedValue
+	rowsClosed       []rowsClosedEvent
+	execResults      []ExecResult
+	connStats        []ConnStats
+	txSummaries      []TxSummary
+	openDurations    []time.Duration
+	openErrs         []error
+}
+
+type rowsClosedEvent struct {
+	d     time.Duration
+	query string
+	rows  int
+	err   error
+}
+
+type resultSetEvent struct {
+	query string
+	index int
+	rows  int
 }
 
 func (h *fakeHook) reset() {
@@ -212,6 +373,19 @@ func (h *fakeHook) reset() {
 	h.execedCount = 0
 	h.rowIteratedCount = 0
 	h.numErr = 0
+	h.txOpts = nil
+	h.pingErrs = nil
+	h.resetErrs = nil
+	h.invalidatedCount = 0
+	h.resultSets = nil
+	h.queriedArgs = nil
+	h.execedArgs = nil
+	h.rowsClosed = nil
+	h.execResults = nil
+	h.connStats = nil
+	h.txSummaries = nil
+	h.openDurations = nil
+	h.openErrs = nil
 }
 
 func (h *fakeHook) ConnOpened(err error) {
@@ -235,6 +409,9 @@ func (h *fakeHook) StmtClosed(err error) {
 func (h *fakeHook) TxBegan(err error) {
 	h.txBeganCount++
 }
+func (h *fakeHook) TxBeganWithOptions(ctx context.Context, opts driver.TxOptions, err error) {
+	h.txOpts = append(h.txOpts, opts)
+}
 func (h *fakeHook) TxCommitted(err error) {
 	h.txCommitedCount++
 }
@@ -247,6 +424,40 @@ func (h *fakeHook) Queried(d time.Duration, query string, err error) {
 func (h *fakeHook) Execed(d time.Duration, query string, err error) {
 	h.execedCount++
 }
+func (h *fakeHook) Pinged(ctx context.Context, d time.Duration, err error) {
+	h.pingErrs = append(h.pingErrs, err)
+}
+func (h *fakeHook) SessionReset(ctx context.Context, d time.Duration, err error) {
+	h.resetErrs = append(h.resetErrs, err)
+}
+func (h *fakeHook) ConnInvalidated(ctx context.Context) {
+	h.invalidatedCount++
+}
+func (h *fakeHook) ResultSetDone(ctx context.Context, query string, index int, rows int) {
+	h.resultSets = append(h.resultSets, resultSetEvent{query: query, index: index, rows: rows})
+}
+func (h *fakeHook) QueriedArgs(ctx context.Context, d time.Duration, query string, args []driver.NamedValue, err error) {
+	h.queriedArgs = append(h.queriedArgs, append([]driver.NamedValue(nil), args...))
+}
+func (h *fakeHook) ExecedArgs(ctx context.Context, d time.Duration, query string, args []driver.NamedValue, err error) {
+	h.execedArgs = append(h.execedArgs, append([]driver.NamedValue(nil), args...))
+}
+func (h *fakeHook) RowsClosed(ctx context.Context, d time.Duration, query string, rows int, err error) {
+	h.rowsClosed = append(h.rowsClosed, rowsClosedEvent{d: d, query: query, rows: rows, err: err})
+}
+func (h *fakeHook) ConnClosedStats(ctx context.Context, stats ConnStats, err error) {
+	h.connStats = append(h.connStats, stats)
+}
+func (h *fakeHook) ConnOpenedWithDuration(ctx context.Context, d time.Duration, err error) {
+	h.openDurations = append(h.openDurations, d)
+	h.openErrs = append(h.openErrs, err)
+}
+func (h *fakeHook) TxEnded(ctx context.Context, summary TxSummary, err error) {
+	h.txSummaries = append(h.txSummaries, summary)
+}
+func (h *fakeHook) ExecResulted(ctx context.Context, query string, r ExecResult) {
+	h.execResults = append(h.execResults, r)
+}
 func (h *fakeHook) RowIterated(err error) {
 	h.rowIteratedCount++
 }
@@ -269,6 +480,55 @@ func TestDriverHandlerValueConverterCorrectly(t *testing.T) {
 	}
 }
 
+func TestDriverForwardsConnNamedValueChecker(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	_, err := db.Exec("UPDATE my_table SET id=?", fakeUUID{})
+	switch {
+	case err != nil:
+		t.Errorf("Exec returned error: %v", err)
+	case !connCheckerCalled:
+		t.Errorf("Expected connection's NamedValueChecker to be called")
+	}
+
+	connCheckerCalled = false
+	stmt, _ := db.Prepare("SELECT c0, c1 FROM my_table WHERE id=?")
+	defer stmt.Close()
+	rows, err := stmt.Query(fakeUUID{})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	rows.Close()
+	if !connCheckerCalled {
+		t.Errorf("Expected connection's NamedValueChecker to be called for a statement without one")
+	}
+}
+
+func TestDriverForwardsStmtNamedValueChecker(t *testing.T) {
+	reset()
+	useNamedValueChecker = true
+	db, _ := sql.Open("fakeStats", "")
+	defer db.Close()
+
+	stmt, _ := db.Prepare("SELECT c0, c1 FROM my_table WHERE id=?")
+	defer stmt.Close()
+	rows, err := stmt.Query(fakeUUID{})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	rows.Close()
+	if !stmtCheckerCalled {
+		t.Errorf("Expected statement's NamedValueChecker to be called")
+	}
+
+	_, err = stmt.Exec(struct{}{})
+	if err == nil {
+		t.Errorf("Expected default conversion to reject unsupported argument type")
+	}
+}
+
 func TestDriverHandlesExecerQueryerCorrectly(t *testing.T) {
 	reset()
 	db, _ := sql.Open("fakeExecerQueryerStats", "")
@@ -329,6 +589,139 @@ func TestDriverHandlesQueryerCorrectly(t *testing.T) {
 	rows.Close()
 }
 
+func TestDriverHandlesContextQueryerExecerCorrectly(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT c0, c1 FROM my_table WHERE myvar=?", 1)
+	switch {
+	case err != nil:
+		t.Fatalf("QueryContext returned error: %v", err)
+	case !queryerCtxCalled:
+		t.Errorf("Expected QueryerContext.QueryContext to be called")
+	case hook.queriedCount != 1:
+		t.Errorf("Expected Queried to be called 1 time, got %d", hook.queriedCount)
+	}
+	rows.Close()
+
+	_, err = db.ExecContext(context.Background(), "UPDATE my_table SET myvar=?", 1)
+	switch {
+	case err != nil:
+		t.Errorf("ExecContext returned error: %v", err)
+	case !execerCtxCalled:
+		t.Errorf("Expected ExecerContext.ExecContext to be called")
+	case hook.execedCount != 1:
+		t.Errorf("Expected Execed to be called 1 time, got %d", hook.execedCount)
+	}
+}
+
+func TestDriverPassesContextToWrapped(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get connection: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var execer driver.ExecerContext
+	conn.Raw(func(dc interface{}) error {
+		execer = dc.(driver.ExecerContext)
+		return nil
+	})
+	_, err = execer.ExecContext(ctx, "UPDATE my_table SET myvar=?", nil)
+	switch {
+	case err != context.Canceled:
+		t.Errorf("Expected context.Canceled to be returned, got %v", err)
+	case !execerCtxCalled:
+		t.Errorf("Expected ExecerContext.ExecContext to be called")
+	case hook.execedCount != 1:
+		t.Errorf("Expected Execed to be called 1 time, got %d", hook.execedCount)
+	}
+}
+
+func TestDriverPassesArgsToArgsHook(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	rows, _ := db.Query("SELECT c0 FROM my_table WHERE a=? AND b=?", int64(1), "secret")
+	rows.Close()
+	db.Exec("UPDATE my_table SET c=:c", sql.Named("c", true))
+
+	wantQuery := []driver.NamedValue{{Ordinal: 1, Value: int64(1)}, {Ordinal: 2, Value: "secret"}}
+	wantExec := []driver.NamedValue{{Name: "c", Ordinal: 1, Value: true}}
+	switch {
+	case len(hook.queriedArgs) != 1 || !reflect.DeepEqual(hook.queriedArgs[0], wantQuery):
+		t.Errorf("Expected QueriedArgs to get %v, got %v", wantQuery, hook.queriedArgs)
+	case len(hook.execedArgs) != 1 || !reflect.DeepEqual(hook.execedArgs[0], wantExec):
+		t.Errorf("Expected ExecedArgs to get %v, got %v", wantExec, hook.execedArgs)
+	}
+}
+
+func TestDriverRedactsArgs(t *testing.T) {
+	reset()
+	d := New(execerQueryer.Open)
+	h := &fakeHook{}
+	d.AddHook(h)
+	d.SetRedactPolicy(RedactTypes(""))
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	args := []interface{}{int64(1), "secret"}
+	db.Exec("UPDATE my_table SET a=? WHERE b=?", args...)
+
+	want := []driver.NamedValue{{Ordinal: 1, Value: int64(1)}, {Ordinal: 2, Value: Redacted}}
+	if len(h.execedArgs) != 1 || !reflect.DeepEqual(h.execedArgs[0], want) {
+		t.Errorf("Expected ExecedArgs to get %v, got %v", want, h.execedArgs)
+	}
+	if args[1] != "secret" {
+		t.Errorf("Expected redaction not to modify the caller's arguments")
+	}
+}
+
+type fakeErrResult struct{}
+
+var errNoLastInsertId = errors.New("LastInsertId is not supported by this driver")
+
+func (r fakeErrResult) LastInsertId() (int64, error) {
+	return 0, errNoLastInsertId
+}
+func (r fakeErrResult) RowsAffected() (int64, error) {
+	return 7, nil
+}
+
+func TestDriverReportsExecResults(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	db.Exec("UPDATE my_table SET myvar=?", 1)
+	want := ExecResult{RowsAffected: 2, LastInsertId: 1}
+	if len(hook.execResults) != 1 || hook.execResults[0] != want {
+		t.Errorf("Expected ExecResulted to get %+v, got %+v", want, hook.execResults)
+	}
+
+	d := newStatsDriver(nil)
+	d.AddHook(hook)
+	d.execed(context.Background(), time.Now(), "DELETE FROM my_table", nil, fakeErrResult{}, nil)
+	want = ExecResult{RowsAffected: 7, LastInsertIdErr: errNoLastInsertId}
+	if len(hook.execResults) != 2 || hook.execResults[1] != want {
+		t.Errorf("Expected ExecResulted to get %+v, got %+v", want, hook.execResults)
+	}
+
+	d.execed(context.Background(), time.Now(), "DELETE FROM my_table", nil, nil, errors.New("failed"))
+	if len(hook.execResults) != 2 {
+		t.Errorf("Expected ExecResulted not to be called for a failed exec")
+	}
+}
+
 func TestDriverKeepsTxStats(t *testing.T) {
 	reset()
 	db, _ := sql.Open("fakeStats", "")
@@ -351,6 +744,51 @@ func TestDriverKeepsTxStats(t *testing.T) {
 	}
 }
 
+func TestDriverForwardsTxOptions(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+	opts := &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true}
+	tx, err := db.BeginTx(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("BeginTx returned error: %v", err)
+	}
+	defer tx.Rollback()
+
+	want := driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSerializable), ReadOnly: true}
+	switch {
+	case beginTxOpts == nil:
+		t.Errorf("Expected ConnBeginTx.BeginTx to be called")
+	case *beginTxOpts != want:
+		t.Errorf("Expected wrapped BeginTx to get %+v, got %+v", want, *beginTxOpts)
+	case hook.txBeganCount != 1:
+		t.Errorf("Expected TxBegan to be called 1 time, got %d", hook.txBeganCount)
+	case len(hook.txOpts) != 1:
+		t.Errorf("Expected TxBeganWithOptions to be called 1 time, got %d", len(hook.txOpts))
+	case hook.txOpts[0] != want:
+		t.Errorf("Expected TxBeganWithOptions to get %+v, got %+v", want, hook.txOpts[0])
+	}
+}
+
+func TestDriverRejectsTxOptionsWhenWrappedDoesNotSupportThem(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeStats", "")
+	defer db.Close()
+	_, err := db.BeginTx(context.Background(), &sql.TxOptions{ReadOnly: true})
+	if err == nil {
+		t.Errorf("Expected error starting read-only transaction on a driver without BeginTx")
+	}
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("BeginTx returned error: %v", err)
+	}
+	tx.Commit()
+	if len(hook.txOpts) != 1 || hook.txOpts[0] != (driver.TxOptions{}) {
+		t.Errorf("Expected default options to be passed to TxBeganWithOptions, got %+v", hook.txOpts)
+	}
+}
+
 func TestDriverKeepsStmtStats(t *testing.T) {
 	reset()
 	db, _ := sql.Open("fakeStats", "")
@@ -386,6 +824,49 @@ func TestDriverKeepsStmtStats(t *testing.T) {
 
 }
 
+func TestDriverKeepsContextStmtStats(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+	ctx := context.Background()
+	stmt, err := db.PrepareContext(ctx, "SELECT now()")
+	switch {
+	case err != nil:
+		t.Fatalf("PrepareContext returned error: %v", err)
+	case !prepareCtxCalled:
+		t.Errorf("Expected ConnPrepareContext.PrepareContext to be called")
+	case hook.stmtPreparedCount != 1:
+		t.Errorf("Expected StmtPrepared to be called 1 time, got %d", hook.stmtPreparedCount)
+	}
+	defer stmt.Close()
+
+	stmt.ExecContext(ctx, 1)
+	switch {
+	case !stmtExecCtxCalled:
+		t.Errorf("Expected StmtExecContext.ExecContext to be called")
+	case hook.execedCount != 1:
+		t.Errorf("Expected Execed to be called 1 time, got %d", hook.execedCount)
+	}
+
+	rows, err := stmt.QueryContext(ctx, 1)
+	switch {
+	case err != nil:
+		t.Fatalf("QueryContext returned error: %v", err)
+	case !stmtQueryCtxCalled:
+		t.Errorf("Expected StmtQueryContext.QueryContext to be called")
+	case hook.queriedCount != 1:
+		t.Errorf("Expected Queried to be called 1 time, got %d", hook.queriedCount)
+	}
+	rows.Close()
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = stmt.ExecContext(cctx, 1)
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled from cancelled ExecContext, got %v", err)
+	}
+}
+
 func TestDriverFowardsToWrapped(t *testing.T) {
 	reset()
 	params := "my params"
@@ -403,6 +884,87 @@ func TestDriverFowardsToWrapped(t *testing.T) {
 	}
 }
 
+func TestDriverForwardsPing(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	err := db.PingContext(context.Background())
+	switch {
+	case err != nil:
+		t.Errorf("Ping returned error: %v", err)
+	case !pingCalled:
+		t.Errorf("Expected Pinger.Ping to be called")
+	case len(hook.pingErrs) != 1:
+		t.Errorf("Expected Pinged to be called 1 time, got %d", len(hook.pingErrs))
+	}
+
+	myErr := errors.New("server unreachable")
+	pingErr = myErr
+	err = db.PingContext(context.Background())
+	switch {
+	case err != myErr:
+		t.Errorf("Expected Ping error to be returned, got %v", err)
+	case len(hook.pingErrs) != 2 || hook.pingErrs[1] != myErr:
+		t.Errorf("Expected Ping error to be passed to hook, got %v", hook.pingErrs)
+	}
+}
+
+func TestDriverPingWithoutPinger(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeStats", "")
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		t.Errorf("Ping returned error: %v", err)
+	}
+	if len(hook.pingErrs) != 0 {
+		t.Errorf("Expected Pinged not to be called for a driver without Pinger, got %d calls", len(hook.pingErrs))
+	}
+}
+
+func TestDriverForwardsSessionResetter(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	db.Exec("UPDATE my_table SET myvar=?", 1)
+	db.Exec("UPDATE my_table SET myvar=?", 1)
+	if len(hook.resetErrs) != 1 {
+		t.Fatalf("Expected SessionReset to be called 1 time, got %d", len(hook.resetErrs))
+	}
+
+	resetErr = driver.ErrBadConn
+	db.Exec("UPDATE my_table SET myvar=?", 1)
+	switch {
+	case len(hook.resetErrs) != 2 || hook.resetErrs[1] != driver.ErrBadConn:
+		t.Errorf("Expected ResetSession error to be passed to hook, got %v", hook.resetErrs)
+	case hook.connClosedCount != 1:
+		t.Errorf("Expected connection with failed reset to be closed, got %d closes", hook.connClosedCount)
+	}
+}
+
+func TestDriverForwardsValidator(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	db.Exec("UPDATE my_table SET myvar=?", 1)
+	if hook.invalidatedCount != 0 {
+		t.Errorf("Expected ConnInvalidated not to be called for a valid connection")
+	}
+
+	connInvalid = true
+	db.Exec("UPDATE my_table SET myvar=?", 1)
+	switch {
+	case hook.invalidatedCount != 1:
+		t.Errorf("Expected ConnInvalidated to be called 1 time, got %d", hook.invalidatedCount)
+	case hook.connClosedCount != 1:
+		t.Errorf("Expected invalid connection to be closed, got %d closes", hook.connClosedCount)
+	}
+}
+
 func TestDriverKeepsConnectionStats(t *testing.T) {
 	reset()
 	db, _ := sql.Open("fakeStats", "")
@@ -454,3 +1016,426 @@ func TestConnCloseReturnsErr(t *testing.T) {
 		t.Errorf("Expected error to be passed to hook")
 	}
 }
+
+type ctxKey struct{}
+
+// fakeContextHook records the ctxKey value and connection ID of the context passed
+// with each event.
+type fakeContextHook struct {
+	fakeHook
+	values  map[string][]interface{}
+	connIDs map[string][]uint64
+}
+
+func (h *fakeContextHook) record(event string, ctx context.Context) {
+	if h.values == nil {
+		h.values = make(map[string][]interface{})
+		h.connIDs = make(map[string][]uint64)
+	}
+	h.values[event] = append(h.values[event], ctx.Value(ctxKey{}))
+	if id, ok := ConnID(ctx); ok {
+		h.connIDs[event] = append(h.connIDs[event], id)
+	}
+}
+
+func (h *fakeContextHook) ConnOpenedContext(ctx context.Context, err error) {
+	h.record("ConnOpened", ctx)
+}
+func (h *fakeContextHook) ConnClosedContext(ctx context.Context, err error) {
+	h.record("ConnClosed", ctx)
+}
+func (h *fakeContextHook) StmtPreparedContext(ctx context.Context, query string, err error) {
+	h.record("StmtPrepared", ctx)
+}
+func (h *fakeContextHook) StmtClosedContext(ctx context.Context, err error) {
+	h.record("StmtClosed", ctx)
+}
+func (h *fakeContextHook) TxBeganContext(ctx context.Context, err error) {
+	h.record("TxBegan", ctx)
+}
+func (h *fakeContextHook) TxCommittedContext(ctx context.Context, err error) {
+	h.record("TxCommitted", ctx)
+}
+func (h *fakeContextHook) TxRolledbackContext(ctx context.Context, err error) {
+	h.record("TxRolledback", ctx)
+}
+func (h *fakeContextHook) QueriedContext(ctx context.Context, d time.Duration, query string, err error) {
+	h.record("Queried", ctx)
+}
+func (h *fakeContextHook) ExecedContext(ctx context.Context, d time.Duration, query string, err error) {
+	h.record("Execed", ctx)
+}
+func (h *fakeContextHook) RowIteratedContext(ctx context.Context, err error) {
+	h.record("RowIterated", ctx)
+}
+
+func TestDriverPassesContextToContextHook(t *testing.T) {
+	reset()
+	d := New(contextConn.Open)
+	h := &fakeContextHook{}
+	d.AddHook(h)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request-1")
+	rows, err := db.QueryContext(ctx, "SELECT c0, c1 FROM my_table")
+	if err != nil {
+		t.Fatalf("QueryContext returned error: %v", err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	db.ExecContext(ctx, "UPDATE my_table SET myvar=?", 1)
+	stmt, _ := db.PrepareContext(ctx, "SELECT now()")
+	stmt.Close()
+	tx, _ := db.BeginTx(ctx, nil)
+	tx.Commit()
+	tx, _ = db.BeginTx(ctx, nil)
+	tx.Rollback()
+	db.Close()
+
+	for _, event := range []string{"Queried", "RowIterated", "Execed", "StmtPrepared", "TxBegan", "TxCommitted", "TxRolledback"} {
+		values := h.values[event]
+		if len(values) == 0 {
+			t.Errorf("Expected %sContext to be called", event)
+			continue
+		}
+		for _, v := range values {
+			if v != "request-1" {
+				t.Errorf("Expected %sContext to get the request context, got value %v", event, v)
+			}
+		}
+	}
+	for _, event := range []string{"ConnOpened", "ConnClosed", "StmtClosed"} {
+		if len(h.values[event]) == 0 {
+			t.Errorf("Expected %sContext to be called", event)
+		}
+	}
+
+	if h.queriedCount != 0 || h.execedCount != 0 || h.connOpenedCount != 0 {
+		t.Errorf("Expected Hook methods not to be called on a ContextHook")
+	}
+}
+
+// loggingConn appends each call to log so that tests can check the order of calls
+// and hook events.
+type loggingConn struct {
+	fakeFullConn
+	log *[]string
+}
+
+func (c *loggingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	*c.log = append(*c.log, "query")
+	return &fakeRows{}, nil
+}
+func (c *loggingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	*c.log = append(*c.log, "exec")
+	return nil, errors.New("exec failed")
+}
+func (c *loggingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	*c.log = append(*c.log, "begin")
+	return &fakeTx{}, nil
+}
+
+type fakeStartHook struct {
+	fakeHook
+	log *[]string
+}
+
+func (h *fakeStartHook) finisher(op string) Finisher {
+	*h.log = append(*h.log, op+" started")
+	return func(err error) {
+		*h.log = append(*h.log, fmt.Sprintf("%s finished: %v", op, err))
+	}
+}
+func (h *fakeStartHook) ConnOpenStarted(ctx context.Context) Finisher {
+	return h.finisher("open")
+}
+func (h *fakeStartHook) QueryStarted(ctx context.Context, query string) Finisher {
+	return h.finisher("query")
+}
+func (h *fakeStartHook) ExecStarted(ctx context.Context, query string) Finisher {
+	return h.finisher("exec")
+}
+func (h *fakeStartHook) TxStarted(ctx context.Context) Finisher {
+	return h.finisher("tx")
+}
+
+func TestDriverCallsStartHooks(t *testing.T) {
+	var log []string
+	d := New(func(name string) (driver.Conn, error) {
+		log = append(log, "open")
+		return &loggingConn{log: &log}, nil
+	})
+	d.AddHook(&fakeStartHook{log: &log})
+	d.AddHook(&fakeStartHook{log: new([]string)}) // a second hook should not affect the first
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	ctx := context.Background()
+	rows, _ := db.QueryContext(ctx, "SELECT 1")
+	rows.Close()
+	db.ExecContext(ctx, "UPDATE t SET c=1")
+	tx, _ := db.BeginTx(ctx, nil)
+	log = append(log, "in tx")
+	tx.Commit()
+
+	want := []string{
+		"open started", "open", "open finished: <nil>",
+		"query started", "query", "query finished: <nil>",
+		"exec started", "exec", "exec finished: exec failed",
+		"tx started", "begin", "in tx", "tx finished: <nil>",
+	}
+	if !reflect.DeepEqual(log, want) {
+		t.Errorf("Expected calls\n%q\ngot\n%q", want, log)
+	}
+}
+
+func TestDriverRemoveHook(t *testing.T) {
+	reset()
+	d := New(fake.Open)
+	h1, h2 := &fakeHook{}, &fakeHook{}
+	d.AddHook(h1)
+	d.AddHook(h2)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	db.Exec("UPDATE my_table SET a=?", 1)
+	d.RemoveHook(h1)
+	db.Exec("UPDATE my_table SET a=?", 2)
+	d.RemoveHook(h1) // removing a hook twice should have no effect
+
+	switch {
+	case h1.execedCount != 1:
+		t.Errorf("Expected removed hook to get 1 Execed event, got %d", h1.execedCount)
+	case h2.execedCount != 2:
+		t.Errorf("Expected remaining hook to get 2 Execed events, got %d", h2.execedCount)
+	}
+}
+
+func TestDriverAddRemoveHookDuringQueries(t *testing.T) {
+	d := New(func(name string) (driver.Conn, error) { return &fakeFullConn{}, nil })
+	always := &CounterHook{}
+	d.AddHook(always)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	const workers, queries = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < queries; j++ {
+				rows, err := db.Query("SELECT a FROM my_table")
+				if err != nil {
+					t.Errorf("Query returned error: %v", err)
+					return
+				}
+				rows.Close()
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < queries; i++ {
+			h := &CounterHook{}
+			d.AddHook(h)
+			d.RemoveHook(h)
+		}
+	}()
+	wg.Wait()
+	<-done
+
+	if always.Queries() != workers*queries {
+		t.Errorf("Expected %d queries, got %d", workers*queries, always.Queries())
+	}
+}
+
+func TestDriverReportsConnIDs(t *testing.T) {
+	reset()
+	d := New(contextConn.Open)
+	h := &fakeContextHook{}
+	d.AddHook(h)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+
+	ctx := context.Background()
+	conn1, _ := db.Conn(ctx)
+	conn2, _ := db.Conn(ctx)
+	conn1.ExecContext(ctx, "UPDATE my_table SET myvar=?", 1)
+	conn2.ExecContext(ctx, "UPDATE my_table SET myvar=?", 2)
+	rows, _ := conn1.QueryContext(ctx, "SELECT c0, c1 FROM my_table")
+	for rows.Next() {
+	}
+	rows.Close()
+	stmt, _ := conn1.PrepareContext(ctx, "SELECT now()")
+	stmt.Close()
+	tx, _ := conn1.BeginTx(ctx, nil)
+	tx.Commit()
+	conn1.Close()
+	conn2.Close()
+	db.Close()
+
+	opened := h.connIDs["ConnOpened"]
+	if len(opened) != 2 || opened[0] == opened[1] {
+		t.Fatalf("Expected 2 connections with different IDs, got %v", opened)
+	}
+	id1, id2 := opened[0], opened[1]
+	if execed := h.connIDs["Execed"]; !reflect.DeepEqual(execed, []uint64{id1, id2}) {
+		t.Errorf("Expected Execed to get IDs %v, got %v", []uint64{id1, id2}, execed)
+	}
+	for _, event := range []string{"Queried", "RowIterated", "StmtPrepared", "StmtClosed", "TxBegan", "TxCommitted"} {
+		ids := h.connIDs[event]
+		if len(ids) == 0 || ids[0] != id1 {
+			t.Errorf("Expected %sContext to get ID %d, got %v", event, id1, ids)
+		}
+	}
+
+	if len(h.connStats) != 2 {
+		t.Fatalf("Expected ConnClosedStats to be called 2 times, got %d", len(h.connStats))
+	}
+	for _, stats := range h.connStats {
+		want := ConnStats{ID: id1, Age: stats.Age, Queries: 1, Execs: 1}
+		if stats.ID == id2 {
+			want = ConnStats{ID: id2, Age: stats.Age, Execs: 1}
+		}
+		if stats != want || stats.Age <= 0 {
+			t.Errorf("Expected ConnClosedStats to get %+v, got %+v", want, stats)
+		}
+	}
+}
+
+func TestDriverReportsTxSummary(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	tx, _ := db.Begin()
+	tx.Exec("UPDATE my_table SET myvar=?", 1)
+	tx.Exec("UPDATE my_table SET myvar=?", 2)
+	rows, _ := tx.Query("SELECT c0, c1 FROM my_table")
+	rows.Close()
+	tx.Commit()
+	db.Exec("UPDATE my_table SET myvar=?", 3) // outside of a transaction
+	tx, _ = db.Begin()
+	tx.Rollback()
+
+	if len(hook.txSummaries) != 2 {
+		t.Fatalf("Expected TxEnded to be called 2 times, got %d", len(hook.txSummaries))
+	}
+	committed, rolledback := hook.txSummaries[0], hook.txSummaries[1]
+	want := TxSummary{Duration: committed.Duration, Queries: 1, Execs: 2, RowsAffected: 4, Committed: true}
+	if committed != want || committed.Duration <= 0 {
+		t.Errorf("Expected %+v, got %+v", want, committed)
+	}
+	want = TxSummary{Duration: rolledback.Duration}
+	if rolledback != want {
+		t.Errorf("Expected %+v, got %+v", want, rolledback)
+	}
+}
+
+func TestDriverTimesConnOpen(t *testing.T) {
+	reset()
+	myErr := errors.New("failed to open")
+	const delay = 10 * time.Millisecond
+	var openErr error
+	d := New(func(name string) (driver.Conn, error) {
+		time.Sleep(delay)
+		if openErr != nil {
+			return nil, openErr
+		}
+		return &fakeConn{}, nil
+	})
+	h := &fakeHook{}
+	d.AddHook(h)
+
+	c, err := d.Open("")
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	c.Close()
+	openErr = myErr
+	d.Open("")
+
+	switch {
+	case len(h.openDurations) != 2:
+		t.Fatalf("Expected ConnOpenedWithDuration to be called 2 times, got %d", len(h.openDurations))
+	case h.openDurations[0] < delay || h.openDurations[1] < delay:
+		t.Errorf("Expected open durations of at least %v, got %v", delay, h.openDurations)
+	case h.openErrs[0] != nil || h.openErrs[1] != myErr:
+		t.Errorf("Expected open errors [<nil> %v], got %v", myErr, h.openErrs)
+	}
+}
+
+// flakyConn returns driver.ErrSkip from every QueryContext, so that database/sql falls
+// back to a prepared statement, and driver.ErrBadConn from the first badExecs execs.
+type flakyConn struct {
+	fakeFullConn
+	badExecs *int
+}
+
+func (c *flakyConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return nil, driver.ErrSkip
+}
+func (c *flakyConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if *c.badExecs > 0 {
+		*c.badExecs--
+		return nil, driver.ErrBadConn
+	}
+	return &fakeResult{}, nil
+}
+
+func TestDriverReportsRetriedErrors(t *testing.T) {
+	badExecs := 1
+	d := New(func(name string) (driver.Conn, error) { return &flakyConn{badExecs: &badExecs}, nil })
+	h := &CounterHook{}
+	d.AddHook(h)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	rows, err := db.Query("SELECT c0, c1 FROM my_table WHERE myvar=?", 1)
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	rows.Close()
+	if _, err := db.Exec("UPDATE my_table SET myvar=?", 1); err != nil {
+		t.Fatalf("Exec returned error: %v", err)
+	}
+
+	switch {
+	case h.Queries() != 1 || h.QueryErrs() != 0:
+		t.Errorf("Expected 1 query and no query errors, got %d and %d", h.Queries(), h.QueryErrs())
+	case h.Execs() != 1 || h.ExecErrs() != 0:
+		t.Errorf("Expected 1 exec and no exec errors, got %d and %d", h.Execs(), h.ExecErrs())
+	case h.BadConns() != 1:
+		t.Errorf("Expected 1 bad connection, got %d", h.BadConns())
+	case h.TotalConns() != 2:
+		t.Errorf("Expected the bad connection to be replaced, got %d connections", h.TotalConns())
+	}
+}
+
+func TestDriverReportsBadConnWhenRetriesFail(t *testing.T) {
+	badExecs := 100
+	d := New(func(name string) (driver.Conn, error) { return &flakyConn{badExecs: &badExecs}, nil })
+	h := &CounterHook{}
+	d.AddHook(h)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err := db.Exec("UPDATE my_table SET myvar=?", 1)
+	switch {
+	case !errors.Is(err, driver.ErrBadConn):
+		t.Errorf("Expected Exec to return driver.ErrBadConn, got %v", err)
+	case h.Execs() != 0 || h.ExecErrs() != 0:
+		t.Errorf("Expected no execs or exec errors, got %d and %d", h.Execs(), h.ExecErrs())
+	case h.BadConns() < 1:
+		t.Errorf("Expected the error the application saw to be counted as a bad connection, got %d", h.BadConns())
+	}
+}
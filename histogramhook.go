@@ -0,0 +1,267 @@
+package dbstats
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultBuckets are the bucket bounds used by a HistogramHook created with nil
+// bounds. They grow exponentially from 100µs to about 52s.
+var DefaultBuckets = ExponentialBuckets(100*time.Microsecond, 2, 20)
+
+// ExponentialBuckets returns count bucket bounds, the first of which is start and each
+// subsequent one factor times the one before. It panics if start is not positive,
+// factor is not greater than 1 or count is less than 1.
+func ExponentialBuckets(start time.Duration, factor float64, count int) []time.Duration {
+	if start <= 0 || factor <= 1 || count < 1 {
+		panic("dbstats: invalid exponential buckets")
+	}
+	bounds := make([]time.Duration, count)
+	b := float64(start)
+	for i := range bounds {
+		bounds[i] = time.Duration(b)
+		b *= factor
+	}
+	return bounds
+}
+
+// HistogramHook is a Hook that records the durations of queries and execs into
+// histograms with fixed buckets. Successful and failed operations are recorded
+// separately, as failures are often timeouts that would skew the distribution.
+// Recording does not lock. The other Hook events are ignored.
+//
+// The zero value is ready to use and records into DefaultBuckets, NewHistogramHook
+// creates a HistogramHook with other buckets.
+type HistogramHook struct {
+	once          sync.Once // initialises the histograms of a zero value HistogramHook
+	queries       histogram
+	failedQueries histogram
+	execs         histogram
+	failedExecs   histogram
+}
+
+// NewHistogramHook returns a HistogramHook whose histograms use the given bucket
+// bounds. Each bound is the inclusive upper limit of a bucket, and a final bucket
+// holds durations greater than the last bound. If bounds is nil DefaultBuckets are
+// used. NewHistogramHook panics if bounds is empty or not in increasing order.
+func NewHistogramHook(bounds []time.Duration) *HistogramHook {
+	if bounds == nil {
+		bounds = DefaultBuckets
+	}
+	if len(bounds) == 0 {
+		panic("dbstats: histogram needs at least one bound")
+	}
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			panic("dbstats: histogram bounds must be increasing")
+		}
+	}
+	h := &HistogramHook{}
+	h.once.Do(func() { h.init(bounds) })
+	return h
+}
+
+func (h *HistogramHook) init(bounds []time.Duration) {
+	bounds = append([]time.Duration(nil), bounds...)
+	h.queries = newHistogram(bounds)
+	h.failedQueries = newHistogram(bounds)
+	h.execs = newHistogram(bounds)
+	h.failedExecs = newHistogram(bounds)
+}
+
+// histograms initialises h with DefaultBuckets if it was not created by
+// NewHistogramHook, and returns it.
+func (h *HistogramHook) histograms() *HistogramHook {
+	h.once.Do(func() { h.init(DefaultBuckets) })
+	return h
+}
+
+// Queries returns the histogram of successful queries.
+func (h *HistogramHook) Queries() Histogram {
+	return h.histograms().queries.load()
+}
+
+// FailedQueries returns the histogram of queries that returned an error.
+func (h *HistogramHook) FailedQueries() Histogram {
+	return h.histograms().failedQueries.load()
+}
+
+// Execs returns the histogram of successful execs.
+func (h *HistogramHook) Execs() Histogram {
+	return h.histograms().execs.load()
+}
+
+// FailedExecs returns the histogram of execs that returned an error.
+func (h *HistogramHook) FailedExecs() Histogram {
+	return h.histograms().failedExecs.load()
+}
+
+// Queried implements Queried of the Hook interface.
+func (h *HistogramHook) Queried(d time.Duration, query string, err error) {
+	if err == nil {
+		h.histograms().queries.record(d)
+	} else {
+		h.histograms().failedQueries.record(d)
+	}
+}
+
+// Execed implements Execed of the Hook interface.
+func (h *HistogramHook) Execed(d time.Duration, query string, err error) {
+	if err == nil {
+		h.histograms().execs.record(d)
+	} else {
+		h.histograms().failedExecs.record(d)
+	}
+}
+
+// ConnOpened implements ConnOpened of the Hook interface. Connections are not
+// recorded.
+func (h *HistogramHook) ConnOpened(err error) {}
+
+// ConnClosed implements ConnClosed of the Hook interface. Connections are not recorded.
+func (h *HistogramHook) ConnClosed(err error) {}
+
+// StmtPrepared implements StmtPrepared of the Hook interface. Statements are not
+// recorded.
+func (h *HistogramHook) StmtPrepared(query string, err error) {}
+
+// StmtClosed implements StmtClosed of the Hook interface. Statements are not recorded.
+func (h *HistogramHook) StmtClosed(err error) {}
+
+// TxBegan implements TxBegan of the Hook interface. Transactions are not recorded.
+func (h *HistogramHook) TxBegan(err error) {}
+
+// TxCommitted implements TxCommitted of the Hook interface. Transactions are not
+// recorded.
+func (h *HistogramHook) TxCommitted(err error) {}
+
+// TxRolledback implements TxRolledback of the Hook interface. Transactions are not
+// recorded.
+func (h *HistogramHook) TxRolledback(err error) {}
+
+// RowIterated implements RowIterated of the Hook interface. Rows are not recorded.
+func (h *HistogramHook) RowIterated(err error) {}
+
+// Histogram is a copy of the counts of one of a HistogramHook's histograms. Counts[i]
+// is the number of durations that were greater than Bounds[i-1] (or zero) and no
+// greater than Bounds[i]. The last count, Counts[len(Bounds)], is the number of
+// durations greater than every bound.
+type Histogram struct {
+	Bounds []time.Duration `json:"bounds"`
+	Counts []int64         `json:"counts"`
+}
+
+// ErrBoundsMismatch is returned by Histogram.Merge when the histograms being merged
+// have different bucket bounds.
+var ErrBoundsMismatch = errors.New("dbstats: histograms have different bounds")
+
+// ErrInvalidHistogram is returned by Histogram.Merge when a histogram does not have
+// exactly one more count than it has bounds.
+var ErrInvalidHistogram = errors.New("dbstats: histogram counts do not match its bounds")
+
+// Count returns the total number of durations recorded.
+func (h Histogram) Count() int64 {
+	var n int64
+	for _, c := range h.Counts {
+		n += c
+	}
+	return n
+}
+
+// Quantile estimates the q-quantile of the recorded durations, for example q = 0.99 for
+// the 99th percentile. Durations are assumed to be spread evenly within their bucket.
+// The estimate for a quantile that falls in the final, unbounded bucket is the last
+// bound. Quantile returns zero if no durations have been recorded, or if h has no bounds
+// or does not have exactly one more count than it has bounds.
+func (h Histogram) Quantile(q float64) time.Duration {
+	if len(h.Bounds) == 0 || !h.valid() {
+		return 0
+	}
+	total := h.Count()
+	if total == 0 {
+		return 0
+	}
+	if q < 0 {
+		q = 0
+	} else if q > 1 {
+		q = 1
+	}
+	rank := q * float64(total)
+	var seen int64
+	for i, c := range h.Counts {
+		if c == 0 || float64(seen+c) < rank {
+			seen += c
+			continue
+		}
+		if i == len(h.Bounds) {
+			break
+		}
+		var lower time.Duration
+		if i > 0 {
+			lower = h.Bounds[i-1]
+		}
+		frac := (rank - float64(seen)) / float64(c)
+		return lower + time.Duration(frac*float64(h.Bounds[i]-lower))
+	}
+	return h.Bounds[len(h.Bounds)-1]
+}
+
+// Merge returns a Histogram holding the counts of both h and o, for example to combine
+// histograms from several processes. It returns ErrBoundsMismatch if h and o do not have
+// the same bounds, and ErrInvalidHistogram if either does not have exactly one more count
+// than it has bounds.
+func (h Histogram) Merge(o Histogram) (Histogram, error) {
+	if !h.valid() || !o.valid() {
+		return Histogram{}, ErrInvalidHistogram
+	}
+	if len(h.Bounds) != len(o.Bounds) {
+		return Histogram{}, ErrBoundsMismatch
+	}
+	for i := range h.Bounds {
+		if h.Bounds[i] != o.Bounds[i] {
+			return Histogram{}, ErrBoundsMismatch
+		}
+	}
+	m := Histogram{
+		Bounds: append([]time.Duration(nil), h.Bounds...),
+		Counts: make([]int64, len(h.Counts)),
+	}
+	for i := range h.Counts {
+		m.Counts[i] = h.Counts[i] + o.Counts[i]
+	}
+	return m, nil
+}
+
+// valid reports whether h has a count for each bucket its bounds describe.
+func (h Histogram) valid() bool {
+	return len(h.Counts) == len(h.Bounds)+1
+}
+
+// histogram counts durations into buckets without locking.
+type histogram struct {
+	bounds []time.Duration
+	counts []int64 // accessed atomically
+}
+
+func newHistogram(bounds []time.Duration) histogram {
+	return histogram{bounds: bounds, counts: make([]int64, len(bounds)+1)}
+}
+
+func (h *histogram) record(d time.Duration) {
+	i := sort.Search(len(h.bounds), func(i int) bool { return h.bounds[i] >= d })
+	atomic.AddInt64(&h.counts[i], 1)
+}
+
+func (h *histogram) load() Histogram {
+	r := Histogram{
+		Bounds: append([]time.Duration(nil), h.bounds...),
+		Counts: make([]int64, len(h.counts)),
+	}
+	for i := range h.counts {
+		r.Counts[i] = atomic.LoadInt64(&h.counts[i])
+	}
+	return r
+}
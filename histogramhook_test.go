@@ -0,0 +1,164 @@
+package dbstats
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExponentialBuckets(t *testing.T) {
+	want := []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond}
+	if got := ExponentialBuckets(time.Millisecond, 2, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestHistogramHookRecords(t *testing.T) {
+	h := NewHistogramHook([]time.Duration{time.Millisecond, 10 * time.Millisecond})
+	h.Queried(time.Millisecond, "SELECT 1", nil)    // bounds are inclusive
+	h.Queried(5*time.Millisecond, "SELECT 1", nil)  // second bucket
+	h.Queried(time.Second, "SELECT 1", nil)         // overflow bucket
+	h.Queried(time.Second, "SELECT 1", anErr)       // failures are kept separately
+	h.Execed(100*time.Microsecond, "UPDATE t", nil) // first bucket
+	h.Execed(20*time.Millisecond, "UPDATE t", anErr)
+
+	for name, c := range map[string]struct {
+		got  Histogram
+		want []int64
+	}{
+		"Queries":       {h.Queries(), []int64{1, 1, 1}},
+		"FailedQueries": {h.FailedQueries(), []int64{0, 0, 1}},
+		"Execs":         {h.Execs(), []int64{1, 0, 0}},
+		"FailedExecs":   {h.FailedExecs(), []int64{0, 0, 1}},
+	} {
+		if !reflect.DeepEqual(c.got.Counts, c.want) {
+			t.Errorf("Expected %s counts %v, got %v", name, c.want, c.got.Counts)
+		}
+	}
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	h := NewHistogramHook(ExponentialBuckets(time.Millisecond, 2, 10))
+	for i := 1; i <= 100; i++ {
+		// 1ms to 100ms, evenly spread.
+		h.Queried(time.Duration(i)*time.Millisecond, "SELECT 1", nil)
+	}
+	hist := h.Queries()
+
+	for _, c := range []struct {
+		q     float64
+		exact time.Duration
+	}{
+		{0.5, 50 * time.Millisecond},
+		{0.95, 95 * time.Millisecond},
+		{0.99, 99 * time.Millisecond},
+	} {
+		got := hist.Quantile(c.q)
+		// With exponential buckets the estimate is within a factor of 2 of the truth.
+		if got < c.exact/2 || got > c.exact*2 {
+			t.Errorf("Expected quantile %v to be close to %v, got %v", c.q, c.exact, got)
+		}
+	}
+	if got := hist.Quantile(0.5); got != 50*time.Millisecond {
+		t.Errorf("Expected interpolation to give exactly 50ms for an even spread, got %v", got)
+	}
+	if got := (Histogram{Bounds: []time.Duration{time.Second}, Counts: []int64{0, 0}}).Quantile(0.5); got != 0 {
+		t.Errorf("Expected the quantile of an empty histogram to be 0, got %v", got)
+	}
+	overflow := Histogram{Bounds: []time.Duration{time.Second}, Counts: []int64{0, 3}}
+	if got := overflow.Quantile(0.99); got != time.Second {
+		t.Errorf("Expected a quantile in the overflow bucket to be the last bound, got %v", got)
+	}
+}
+
+func TestHistogramQuantileMalformed(t *testing.T) {
+	for _, h := range []Histogram{
+		{Counts: []int64{1}},
+		{Bounds: []time.Duration{time.Millisecond}, Counts: []int64{0, 0, 5}},
+		{Bounds: []time.Duration{time.Millisecond}, Counts: []int64{5}},
+	} {
+		if got := h.Quantile(0.5); got != 0 {
+			t.Errorf("Expected the quantile of %+v to be 0, got %v", h, got)
+		}
+	}
+}
+
+func TestHistogramMerge(t *testing.T) {
+	bounds := []time.Duration{time.Millisecond, time.Second}
+	a, b := NewHistogramHook(bounds), NewHistogramHook(bounds)
+	a.Queried(time.Microsecond, "SELECT 1", nil)
+	b.Queried(time.Millisecond*5, "SELECT 1", nil)
+	b.Queried(time.Minute, "SELECT 1", nil)
+
+	m, err := a.Queries().Merge(b.Queries())
+	switch {
+	case err != nil:
+		t.Fatalf("Merge returned error: %v", err)
+	case !reflect.DeepEqual(m.Counts, []int64{1, 1, 1}):
+		t.Errorf("Expected merged counts [1 1 1], got %v", m.Counts)
+	case m.Count() != 3:
+		t.Errorf("Expected merged count 3, got %d", m.Count())
+	}
+
+	if _, err := m.Merge(NewHistogramHook(nil).Queries()); err != ErrBoundsMismatch {
+		t.Errorf("Expected ErrBoundsMismatch, got %v", err)
+	}
+
+	if _, err := m.Merge(Histogram{Bounds: bounds, Counts: []int64{1}}); err != ErrInvalidHistogram {
+		t.Errorf("Expected ErrInvalidHistogram, got %v", err)
+	}
+	m2, _ := m.Merge(m)
+	if m2.Bounds[0] = time.Hour; m.Bounds[0] != time.Millisecond {
+		t.Errorf("Expected Merge to copy the bounds, got %v", m.Bounds)
+	}
+
+	var decoded Histogram
+	b2, _ := json.Marshal(m)
+	if err := json.Unmarshal(b2, &decoded); err != nil || !reflect.DeepEqual(decoded, m) {
+		t.Errorf("Expected Histogram to round trip through JSON, got %+v (%v)", decoded, err)
+	}
+}
+
+func TestHistogramHookConcurrent(t *testing.T) {
+	h := NewHistogramHook(nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				h.Execed(time.Duration(j)*time.Millisecond, "UPDATE t", nil)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := h.Execs().Count(); got != 800 {
+		t.Errorf("Expected 800 execs, got %d", got)
+	}
+}
+
+func TestNewHistogramHookPanicsOnBadBounds(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected NewHistogramHook to panic")
+		}
+	}()
+	NewHistogramHook([]time.Duration{time.Second, time.Millisecond})
+}
+
+func TestHistogramHookZeroValue(t *testing.T) {
+	h := &HistogramHook{}
+	h.Queried(time.Millisecond, "SELECT 1", nil)
+
+	q := h.Queries()
+	switch {
+	case !reflect.DeepEqual(q.Bounds, DefaultBuckets):
+		t.Errorf("Expected the zero value to use DefaultBuckets, got %v", q.Bounds)
+	case q.Count() != 1:
+		t.Errorf("Expected 1 query, got %d", q.Count())
+	case h.FailedExecs().Count() != 0:
+		t.Errorf("Expected no failed execs, got %d", h.FailedExecs().Count())
+	}
+}
@@ -0,0 +1,279 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// OpKind identifies the kind of database operation an Op describes.
+type OpKind int
+
+const (
+	OpQuery     OpKind = iota + 1 // a query run directly on a connection
+	OpExec                        // an exec run directly on a connection
+	OpStmtQuery                   // a query run with a prepared statement
+	OpStmtExec                    // an exec run with a prepared statement
+	OpPrepare                     // preparing a statement
+	OpBegin                       // beginning a transaction
+	OpCommit                      // committing a transaction
+	OpRollback                    // rolling back a transaction
+	OpPing                        // pinging a connection
+)
+
+var opKindNames = map[OpKind]string{
+	OpQuery:     "query",
+	OpExec:      "exec",
+	OpStmtQuery: "stmt query",
+	OpStmtExec:  "stmt exec",
+	OpPrepare:   "prepare",
+	OpBegin:     "begin",
+	OpCommit:    "commit",
+	OpRollback:  "rollback",
+	OpPing:      "ping",
+}
+
+func (k OpKind) String() string {
+	if name, ok := opKindNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Op describes a database operation passed through the interceptor chain. Interceptors
+// may modify Query, Args and TxOptions before passing the Op on.
+type Op struct {
+	Kind OpKind
+
+	// Query is the query being run or prepared. For OpStmtQuery and OpStmtExec it is the
+	// query the statement was prepared with, and changing it has no effect. It is empty
+	// for the other kinds.
+	Query string
+
+	// Args are the arguments of a query or exec. Arguments passed without a name or
+	// ordinal, as with the driver.Queryer and driver.Execer interfaces, are given their
+	// ordinal position.
+	Args []driver.NamedValue
+
+	// TxOptions are the options of an OpBegin. They are the zero value for transactions
+	// started with Begin.
+	TxOptions driver.TxOptions
+
+	conn *statsConn // the connection an OpQuery, OpExec, OpPrepare, OpBegin or OpPing runs on
+	stmt *statsStmt // the statement an OpStmtQuery or OpStmtExec runs with
+	tx   *statsTx   // the transaction an OpBegin, OpCommit or OpRollback is for
+}
+
+// OpResult holds the result of an Op. Only the field that matches the Op's kind is set:
+// Rows for queries, Result for execs, Stmt for OpPrepare and Tx for OpBegin.
+type OpResult struct {
+	Rows   driver.Rows
+	Result driver.Result
+	Stmt   driver.Stmt
+	Tx     driver.Tx
+}
+
+// statsConn returns the connection op runs on.
+func (op *Op) statsConn() *statsConn {
+	switch {
+	case op.conn != nil:
+		return op.conn
+	case op.stmt != nil:
+		return op.stmt.conn
+	}
+	return op.tx.conn
+}
+
+// Handler performs an Op.
+type Handler func(ctx context.Context, op *Op) (OpResult, error)
+
+// Interceptor is a middleware around database operations, for example to rate limit,
+// add comments to queries or inject faults. It is called with each Op and the next
+// Handler in the chain. It may modify op before calling next, call next more than once
+// in order to retry, or return an error without calling next in order to reject the
+// operation.
+//
+// database/sql considers a transaction finished once Commit or Rollback returns, even
+// with an error. If an OpCommit or OpRollback is finished without reaching the wrapped
+// transaction, the wrapped transaction is therefore rolled back so that it does not
+// stay open on the connection. A rejected commit is a rollback. Likewise, if an OpBegin
+// returns an error after the wrapped transaction was begun, the transaction is rolled
+// back, as database/sql never sees it.
+type Interceptor func(ctx context.Context, op *Op, next Handler) (OpResult, error)
+
+// chain returns a Handler that passes each Op through interceptors, in order, before
+// passing it to h.
+func chain(interceptors []Interceptor, h Handler) Handler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		in, next := interceptors[i], h
+		h = func(ctx context.Context, op *Op) (OpResult, error) {
+			return in(ctx, op, next)
+		}
+	}
+	return h
+}
+
+// hookInterceptor is the first interceptor of every driver's chain. It reports each Op
+// to the driver's hooks, so hooks see operations as the application issued them: before
+// any changes made by later interceptors, and once no matter how many times those
+// interceptors retry. It also adds the ID of the Op's connection to the context passed
+// along the chain, so that it can be read with ConnID.
+func (s *statsDriver) hookInterceptor(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+	query, args := op.Query, op.Args
+	c := op.statsConn()
+	ctx = c.context(ctx)
+	switch op.Kind {
+	case OpQuery, OpStmtQuery:
+		fs := s.QueryStarted(ctx, query)
+		start := time.Now()
+		res, err := next(ctx, op)
+		fs.finish(err)
+		if s.skipped(ctx, op.Kind, query, err) {
+			return res, err
+		}
+		atomic.AddInt64(&c.queries, 1)
+		if c.tx != nil {
+			c.tx.queries++
+		}
+		res.Rows, err = s.queried(ctx, start, query, args, res.Rows, err)
+		return res, err
+	case OpExec, OpStmtExec:
+		fs := s.ExecStarted(ctx, query)
+		start := time.Now()
+		res, err := next(ctx, op)
+		fs.finish(err)
+		if s.skipped(ctx, op.Kind, query, err) {
+			return res, err
+		}
+		atomic.AddInt64(&c.execs, 1)
+		s.execed(ctx, start, query, args, res.Result, err)
+		if c.tx != nil {
+			c.tx.execed(res.Result, err)
+		}
+		return res, err
+	case OpPrepare:
+		res, err := next(ctx, op)
+		if !s.skipped(ctx, op.Kind, query, err) {
+			s.StmtPrepared(ctx, query, err)
+		}
+		return res, err
+	case OpBegin:
+		opts := op.TxOptions
+		fs := s.TxStarted(ctx)
+		start := time.Now()
+		res, err := next(ctx, op)
+		if s.skipped(ctx, op.Kind, query, err) {
+			fs.finish(err)
+			return res, err
+		}
+		s.TxBegan(ctx, err)
+		s.TxBeganWithOptions(ctx, opts, err)
+		if err != nil {
+			fs.finish(err)
+		} else {
+			op.tx.fs, op.tx.start = fs, start
+			c.tx = op.tx
+		}
+		return res, err
+	case OpCommit:
+		res, err := next(ctx, op)
+		op.tx.fs.finish(err)
+		s.TxCommitted(ctx, err)
+		s.TxEnded(ctx, op.tx.summary(true), err)
+		c.tx = nil
+		return res, err
+	case OpRollback:
+		res, err := next(ctx, op)
+		op.tx.fs.finish(err)
+		s.TxRolledback(ctx, err)
+		s.TxEnded(ctx, op.tx.summary(false), err)
+		c.tx = nil
+		return res, err
+	case OpPing:
+		start := time.Now()
+		res, err := next(ctx, op)
+		if !s.skipped(ctx, op.Kind, query, err) {
+			s.Pinged(ctx, time.Now().Sub(start), err)
+		}
+		return res, err
+	}
+	return next(ctx, op)
+}
+
+// skipped reports whether the driver returned driver.ErrSkip, in which case database/sql
+// falls back to another way of running the operation and the caller should report
+// nothing about it. It also reports bad connections to BadConnHooks. As database/sql
+// returns the error of its last retry to the application, those operations are
+// reported as failed as well.
+func (s *statsDriver) skipped(ctx context.Context, kind OpKind, query string, err error) bool {
+	if err == driver.ErrSkip {
+		return true
+	}
+	if errors.Is(err, driver.ErrBadConn) {
+		s.BadConn(ctx, kind, query)
+	}
+	return false
+}
+
+// perform is the last Handler of every driver's chain. It passes op to the wrapped
+// driver.
+func (s *statsDriver) perform(ctx context.Context, op *Op) (OpResult, error) {
+	var res OpResult
+	var err error
+	switch op.Kind {
+	case OpQuery:
+		res.Rows, err = op.conn.runQuery(ctx, op.Query, op.Args)
+	case OpExec:
+		res.Result, err = op.conn.runExec(ctx, op.Query, op.Args)
+	case OpStmtQuery:
+		res.Rows, err = op.stmt.runQuery(ctx, op.Args)
+	case OpStmtExec:
+		res.Result, err = op.stmt.runExec(ctx, op.Args)
+	case OpPrepare:
+		res.Stmt, err = op.conn.runPrepare(ctx, op.Query)
+	case OpBegin:
+		res.Tx, err = op.conn.runBegin(ctx, op.TxOptions)
+	case OpCommit:
+		op.tx.ended = true
+		err = op.tx.wrapped.Commit()
+	case OpRollback:
+		op.tx.ended = true
+		err = op.tx.wrapped.Rollback()
+	case OpPing:
+		err = op.conn.wrapped.(driver.Pinger).Ping(ctx)
+	default:
+		err = errors.New("dbstats: unknown operation " + op.Kind.String())
+	}
+	return res, err
+}
+
+// run passes op through the driver's interceptor chain.
+func (s *statsDriver) run(ctx context.Context, op *Op) (OpResult, error) {
+	return s.handler(ctx, op)
+}
+
+func (s *statsDriver) AddInterceptor(i Interceptor) {
+	s.interceptors = append(s.interceptors, i)
+	s.handler = chain(s.interceptors, s.perform)
+}
+
+func namedValueToValue(named []driver.NamedValue) ([]driver.Value, error) {
+	dargs := make([]driver.Value, len(named))
+	for n, param := range named {
+		if len(param.Name) > 0 {
+			return nil, errors.New("sql: driver does not support the use of Named Parameters")
+		}
+		dargs[n] = param.Value
+	}
+	return dargs, nil
+}
+
+func valueToNamedValue(args []driver.Value) []driver.NamedValue {
+	nargs := make([]driver.NamedValue, len(args))
+	for n, v := range args {
+		nargs[n] = driver.NamedValue{Ordinal: n + 1, Value: v}
+	}
+	return nargs
+}
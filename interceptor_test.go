@@ -0,0 +1,244 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// recordingConn records the queries and arguments that reach the wrapped connection.
+// Its first failures execs return errFlaky.
+type recordingConn struct {
+	fakeContextConn
+	queries  []string
+	args     [][]driver.NamedValue
+	failures int
+}
+
+var errFlaky = errors.New("flaky")
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.queries = append(c.queries, query)
+	c.args = append(c.args, args)
+	return &fakeRows{}, nil
+}
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.queries = append(c.queries, query)
+	c.args = append(c.args, args)
+	if c.failures > 0 {
+		c.failures--
+		return nil, errFlaky
+	}
+	return &fakeResult{}, nil
+}
+
+func openRecording(c *recordingConn, interceptors ...Interceptor) (*sql.DB, *fakeHook, *CounterHook) {
+	d := New(func(name string) (driver.Conn, error) { return c, nil })
+	h, ch := &fakeHook{}, &CounterHook{}
+	d.AddHook(h)
+	d.AddHook(ch)
+	for _, i := range interceptors {
+		d.AddInterceptor(i)
+	}
+	dc, _ := d.OpenConnector("")
+	return sql.OpenDB(dc), h, ch
+}
+
+func TestInterceptorRewritesQuery(t *testing.T) {
+	c := &recordingConn{}
+	var kinds []OpKind
+	db, h, _ := openRecording(c, func(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+		kinds = append(kinds, op.Kind)
+		op.Query += " /* app=test */"
+		op.Args = append(op.Args, driver.NamedValue{Ordinal: len(op.Args) + 1, Value: int64(2)})
+		return next(ctx, op)
+	})
+	defer db.Close()
+
+	rows, err := db.Query("SELECT a FROM my_table WHERE b=?", 1)
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	rows.Close()
+
+	wantArgs := []driver.NamedValue{{Ordinal: 1, Value: int64(1)}, {Ordinal: 2, Value: int64(2)}}
+	switch {
+	case !reflect.DeepEqual(kinds, []OpKind{OpQuery}):
+		t.Errorf("Expected interceptor to see a single query, got %v", kinds)
+	case len(c.queries) != 1 || c.queries[0] != "SELECT a FROM my_table WHERE b=? /* app=test */":
+		t.Errorf("Expected wrapped conn to get the rewritten query, got %q", c.queries)
+	case !reflect.DeepEqual(c.args[0], wantArgs):
+		t.Errorf("Expected wrapped conn to get %v, got %v", wantArgs, c.args[0])
+	case h.queriedCount != 1:
+		t.Errorf("Expected Queried to be called 1 time, got %d", h.queriedCount)
+	case len(h.queriedArgs) != 1 || len(h.queriedArgs[0]) != 1:
+		t.Errorf("Expected hooks to see the arguments the application passed, got %v", h.queriedArgs)
+	}
+}
+
+func TestInterceptorRejectsWithoutCallingWrapped(t *testing.T) {
+	c := &recordingConn{}
+	errLimited := errors.New("rate limited")
+	db, _, ch := openRecording(c, func(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+		if op.Kind == OpExec {
+			return OpResult{}, errLimited
+		}
+		return next(ctx, op)
+	})
+	defer db.Close()
+
+	_, err := db.Exec("DELETE FROM my_table")
+	switch {
+	case err != errLimited:
+		t.Errorf("Expected interceptor error to be returned, got %v", err)
+	case len(c.queries) != 0:
+		t.Errorf("Expected wrapped conn not to be called, got %q", c.queries)
+	case ch.Execs() != 0 || ch.ExecErrs() != 1:
+		t.Errorf("Expected 1 exec error and no execs, got %d errors and %d execs", ch.ExecErrs(), ch.Execs())
+	}
+}
+
+func TestInterceptorRetries(t *testing.T) {
+	c := &recordingConn{failures: 2}
+	db, _, ch := openRecording(c, func(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+		for {
+			res, err := next(ctx, op)
+			if err != errFlaky {
+				return res, err
+			}
+		}
+	})
+	defer db.Close()
+
+	if _, err := db.Exec("UPDATE my_table SET a=1"); err != nil {
+		t.Fatalf("Exec returned error: %v", err)
+	}
+	switch {
+	case len(c.queries) != 3:
+		t.Errorf("Expected wrapped conn to be called 3 times, got %d", len(c.queries))
+	case ch.Execs() != 1 || ch.ExecErrs() != 0:
+		t.Errorf("Expected 1 exec and no exec errors, got %d execs and %d errors", ch.Execs(), ch.ExecErrs())
+	}
+}
+
+func TestInterceptorChainOrder(t *testing.T) {
+	c := &recordingConn{}
+	var log []string
+	logging := func(name string) Interceptor {
+		return func(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+			log = append(log, name+" "+op.Kind.String())
+			return next(ctx, op)
+		}
+	}
+	db, h, _ := openRecording(c, logging("first"), logging("second"))
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("Begin returned error: %v", err)
+	}
+	tx.Commit()
+
+	want := []string{"first begin", "second begin", "first commit", "second commit"}
+	switch {
+	case !reflect.DeepEqual(log, want):
+		t.Errorf("Expected interceptors to be called as %q, got %q", want, log)
+	case h.txBeganCount != 1 || h.txCommitedCount != 1:
+		t.Errorf("Expected TxBegan and TxCommitted to be called once, got %d and %d", h.txBeganCount, h.txCommitedCount)
+	}
+}
+
+// fakeRecordingTx records how it was ended.
+type fakeRecordingTx struct {
+	committed, rolledback int
+	rollbackErr           error
+}
+
+func (t *fakeRecordingTx) Commit() error {
+	t.committed++
+	return nil
+}
+func (t *fakeRecordingTx) Rollback() error {
+	t.rolledback++
+	return t.rollbackErr
+}
+
+type recordingTxConn struct {
+	fakeFullConn
+	tx *fakeRecordingTx
+}
+
+func (c *recordingTxConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.tx, nil
+}
+
+func TestInterceptorRejectedCommitRollsBack(t *testing.T) {
+	tx := &fakeRecordingTx{}
+	d := New(func(name string) (driver.Conn, error) { return &recordingTxConn{tx: tx}, nil })
+	h := &fakeHook{}
+	d.AddHook(h)
+	errRejected := errors.New("commit rejected")
+	d.AddInterceptor(func(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+		if op.Kind == OpCommit {
+			return OpResult{}, errRejected
+		}
+		return next(ctx, op)
+	})
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	sqlTx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("Begin returned error: %v", err)
+	}
+	err = sqlTx.Commit()
+	switch {
+	case err != errRejected:
+		t.Errorf("Expected the interceptor's error to be returned, got %v", err)
+	case tx.committed != 0 || tx.rolledback != 1:
+		t.Errorf("Expected wrapped transaction to be rolled back once, got %d commits and %d rollbacks", tx.committed, tx.rolledback)
+	case h.txCommitedCount != 1:
+		t.Errorf("Expected TxCommitted to be called 1 time, got %d", h.txCommitedCount)
+	}
+
+	sqlTx, _ = db.Begin()
+	sqlTx.Rollback()
+	if tx.rolledback != 2 {
+		t.Errorf("Expected a rollback that reaches the wrapped transaction not to be repeated, got %d rollbacks", tx.rolledback)
+	}
+
+	tx.rollbackErr = errors.New("rollback failed")
+	sqlTx, _ = db.Begin()
+	err = sqlTx.Commit()
+	if !errors.Is(err, errRejected) || !errors.Is(err, tx.rollbackErr) {
+		t.Errorf("Expected both the interceptor's and the rollback's error to be returned, got %v", err)
+	}
+}
+
+func TestInterceptorFailedBeginRollsBack(t *testing.T) {
+	tx := &fakeRecordingTx{}
+	d := New(func(name string) (driver.Conn, error) { return &recordingTxConn{tx: tx}, nil })
+	errRejected := errors.New("begin rejected")
+	d.AddInterceptor(func(ctx context.Context, op *Op, next Handler) (OpResult, error) {
+		res, err := next(ctx, op)
+		if op.Kind == OpBegin && err == nil {
+			return res, errRejected
+		}
+		return res, err
+	})
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err := db.Begin()
+	switch {
+	case err != errRejected:
+		t.Errorf("Expected the interceptor's error to be returned, got %v", err)
+	case tx.committed != 0 || tx.rolledback != 1:
+		t.Errorf("Expected wrapped transaction to be rolled back once, got %d commits and %d rollbacks", tx.committed, tx.rolledback)
+	}
+}
@@ -0,0 +1,76 @@
+package dbstats
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Latency summarises the durations of one type of operation.
+type Latency struct {
+	Count int           `json:"count"` // the number of operations timed
+	Total time.Duration `json:"total"` // the sum of their durations
+	Min   time.Duration `json:"min"`   // the shortest duration, zero if Count is zero
+	Max   time.Duration `json:"max"`   // the longest duration
+}
+
+// Mean returns the mean duration, or zero if no operations have been timed.
+func (l Latency) Mean() time.Duration {
+	if l.Count == 0 {
+		return 0
+	}
+	return l.Total / time.Duration(l.Count)
+}
+
+// Sub returns the difference between l and an earlier Latency o. Count and Total are
+// subtracted, so the mean of the result is the mean of the operations in between. Min
+// and Max cannot be recovered for an interval, so they are taken from l.
+func (l Latency) Sub(o Latency) Latency {
+	l.Count -= o.Count
+	l.Total -= o.Total
+	return l
+}
+
+// latency accumulates durations without locking. min is stored plus one, so that its
+// zero value means no duration has been recorded yet.
+type latency struct {
+	count int64
+	total int64
+	min   int64
+	max   int64
+}
+
+func (l *latency) record(d time.Duration) {
+	n := int64(d)
+	atomic.AddInt64(&l.count, 1)
+	atomic.AddInt64(&l.total, n)
+	for {
+		min := atomic.LoadInt64(&l.min)
+		if min != 0 && min <= n+1 {
+			break
+		}
+		if atomic.CompareAndSwapInt64(&l.min, min, n+1) {
+			break
+		}
+	}
+	for {
+		max := atomic.LoadInt64(&l.max)
+		if max >= n {
+			break
+		}
+		if atomic.CompareAndSwapInt64(&l.max, max, n) {
+			break
+		}
+	}
+}
+
+func (l *latency) load() Latency {
+	r := Latency{
+		Count: int(atomic.LoadInt64(&l.count)),
+		Total: time.Duration(atomic.LoadInt64(&l.total)),
+		Max:   time.Duration(atomic.LoadInt64(&l.max)),
+	}
+	if min := atomic.LoadInt64(&l.min); min != 0 {
+		r.Min = time.Duration(min - 1)
+	}
+	return r
+}
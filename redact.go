@@ -0,0 +1,89 @@
+package dbstats
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// Redacted is the value reported to ArgsHooks in place of an argument redacted by one
+// of the policies in this package.
+const Redacted = "[REDACTED]"
+
+// RedactPolicy decides which query arguments are masked before they are passed to
+// ArgsHooks. It is called for each argument of each query and exec and returns the
+// value to report in place of arg.Value and true if the argument should be masked, or
+// false to report the argument as is. A RedactPolicy may be called concurrently.
+type RedactPolicy func(query string, arg driver.NamedValue) (driver.Value, bool)
+
+// RedactPositions returns a RedactPolicy that masks the arguments at the given
+// ordinal positions. As in driver.NamedValue, ordinals start at one.
+func RedactPositions(ordinals ...int) RedactPolicy {
+	set := make(map[int]bool, len(ordinals))
+	for _, o := range ordinals {
+		set[o] = true
+	}
+	return func(query string, arg driver.NamedValue) (driver.Value, bool) {
+		return Redacted, set[arg.Ordinal]
+	}
+}
+
+// RedactNames returns a RedactPolicy that masks the named arguments (sql.Named) with
+// the given names.
+func RedactNames(names ...string) RedactPolicy {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+	return func(query string, arg driver.NamedValue) (driver.Value, bool) {
+		return Redacted, arg.Name != "" && set[arg.Name]
+	}
+}
+
+// RedactTypes returns a RedactPolicy that masks every argument whose value has the same
+// type as one of samples. For example RedactTypes("", []byte(nil)) masks all string and
+// []byte arguments.
+func RedactTypes(samples ...interface{}) RedactPolicy {
+	set := make(map[reflect.Type]bool, len(samples))
+	for _, s := range samples {
+		set[reflect.TypeOf(s)] = true
+	}
+	return func(query string, arg driver.NamedValue) (driver.Value, bool) {
+		return Redacted, arg.Value != nil && set[reflect.TypeOf(arg.Value)]
+	}
+}
+
+// RedactAny returns a RedactPolicy that masks an argument with the first of policies
+// that masks it.
+func RedactAny(policies ...RedactPolicy) RedactPolicy {
+	return func(query string, arg driver.NamedValue) (driver.Value, bool) {
+		for _, p := range policies {
+			if v, ok := p(query, arg); ok {
+				return v, true
+			}
+		}
+		return nil, false
+	}
+}
+
+// redact returns args with the arguments p masks replaced. args is only copied if an
+// argument is masked.
+func (p RedactPolicy) redact(query string, args []driver.NamedValue) []driver.NamedValue {
+	if p == nil {
+		return args
+	}
+	redacted := args
+	copied := false
+	for i, arg := range args {
+		v, ok := p(query, arg)
+		if !ok {
+			continue
+		}
+		if !copied {
+			redacted = make([]driver.NamedValue, len(args))
+			copy(redacted, args)
+			copied = true
+		}
+		redacted[i].Value = v
+	}
+	return redacted
+}
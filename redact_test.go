@@ -0,0 +1,62 @@
+package dbstats
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testArgs() []driver.NamedValue {
+	return []driver.NamedValue{
+		{Ordinal: 1, Value: int64(42)},
+		{Ordinal: 2, Value: "alice@example.com"},
+		{Name: "ssn", Ordinal: 3, Value: []byte("123-45-6789")},
+		{Ordinal: 4, Value: nil},
+	}
+}
+
+func TestRedactPolicies(t *testing.T) {
+	const query = "SELECT * FROM users WHERE id=? AND email=? AND ssn=:ssn AND deleted_at=?"
+	tests := []struct {
+		name   string
+		policy RedactPolicy
+		want   []driver.Value
+	}{
+		{"nil", nil, []driver.Value{int64(42), "alice@example.com", []byte("123-45-6789"), nil}},
+		{"positions", RedactPositions(1, 4), []driver.Value{Redacted, "alice@example.com", []byte("123-45-6789"), Redacted}},
+		{"names", RedactNames("ssn", "email"), []driver.Value{int64(42), "alice@example.com", Redacted, nil}},
+		{"types", RedactTypes("", []byte(nil)), []driver.Value{int64(42), Redacted, Redacted, nil}},
+		{"func", RedactPolicy(func(query string, arg driver.NamedValue) (driver.Value, bool) {
+			s, ok := arg.Value.(string)
+			if !ok {
+				return nil, false
+			}
+			return s[strings.Index(s, "@"):], true
+		}), []driver.Value{int64(42), "@example.com", []byte("123-45-6789"), nil}},
+		{"any", RedactAny(RedactPositions(1), RedactNames("ssn")), []driver.Value{Redacted, "alice@example.com", Redacted, nil}},
+	}
+
+	for _, test := range tests {
+		args := testArgs()
+		redacted := test.policy.redact(query, args)
+		var got []driver.Value
+		for _, arg := range redacted {
+			got = append(got, arg.Value)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+		if !reflect.DeepEqual(args, testArgs()) {
+			t.Errorf("%s: expected redaction not to modify the original arguments", test.name)
+		}
+	}
+}
+
+func TestRedactDoesNotCopyUnmaskedArgs(t *testing.T) {
+	args := testArgs()
+	redacted := RedactPositions(10).redact("SELECT 1", args)
+	if &redacted[0] != &args[0] {
+		t.Errorf("Expected arguments to be returned as is when none are masked")
+	}
+}
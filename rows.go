@@ -0,0 +1,115 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"time"
+)
+
+// The optional driver.Rows interfaces all embed driver.Rows, so embedding one of them
+// next to driver.Rows would make the driver.Rows methods ambiguous. The interfaces
+// below contain only the additional method and are what wrapRows embeds instead.
+
+type rowsColumnTypeScanType interface {
+	ColumnTypeScanType(index int) reflect.Type
+}
+
+type rowsColumnTypeDatabaseTypeName interface {
+	ColumnTypeDatabaseTypeName(index int) string
+}
+
+type rowsColumnTypeLength interface {
+	ColumnTypeLength(index int) (length int64, ok bool)
+}
+
+type rowsColumnTypeNullable interface {
+	ColumnTypeNullable(index int) (nullable, ok bool)
+}
+
+type rowsColumnTypePrecisionScale interface {
+	ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool)
+}
+
+type rowsNextResultSet interface {
+	HasNextResultSet() bool
+	NextResultSet() error
+}
+
+// statsRows implements every optional rows interface that database/sql checks for.
+// wrapRows is used to expose only the interfaces the wrapped rows implement.
+type statsRows struct {
+	d       *statsDriver
+	ctx     context.Context // the context the query was run with
+	start   time.Time       // when the query was started
+	query   string
+	wrapped driver.Rows
+
+	resultSet int // the index of the current result set
+	rows      int // the number of rows iterated in the current result set
+	total     int // the number of rows iterated in all result sets
+}
+
+func (r *statsRows) Columns() []string {
+	return r.wrapped.Columns()
+}
+
+func (r *statsRows) Close() error {
+	err := r.wrapped.Close()
+	r.d.ResultSetDone(r.ctx, r.query, r.resultSet, r.rows)
+	r.d.RowsClosed(r.ctx, time.Now().Sub(r.start), r.query, r.total, err)
+	return err
+}
+
+func (r *statsRows) Next(dest []driver.Value) error {
+	err := r.wrapped.Next(dest)
+	r.iterated(err)
+	return err
+}
+
+// iterated counts the row fetched by Next or NextRow and reports it to the hooks.
+func (r *statsRows) iterated(err error) {
+	if err == nil {
+		r.rows++
+		r.total++
+	}
+	if err != io.EOF {
+		r.d.RowIterated(r.ctx, err)
+	}
+}
+
+func (r *statsRows) HasNextResultSet() bool {
+	return r.wrapped.(driver.RowsNextResultSet).HasNextResultSet()
+}
+
+func (r *statsRows) NextResultSet() error {
+	err := r.wrapped.(driver.RowsNextResultSet).NextResultSet()
+	if err != nil {
+		return err
+	}
+	r.d.ResultSetDone(r.ctx, r.query, r.resultSet, r.rows)
+	r.resultSet++
+	r.rows = 0
+	return nil
+}
+
+func (r *statsRows) ColumnTypeScanType(index int) reflect.Type {
+	return r.wrapped.(driver.RowsColumnTypeScanType).ColumnTypeScanType(index)
+}
+
+func (r *statsRows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.wrapped.(driver.RowsColumnTypeDatabaseTypeName).ColumnTypeDatabaseTypeName(index)
+}
+
+func (r *statsRows) ColumnTypeLength(index int) (length int64, ok bool) {
+	return r.wrapped.(driver.RowsColumnTypeLength).ColumnTypeLength(index)
+}
+
+func (r *statsRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	return r.wrapped.(driver.RowsColumnTypeNullable).ColumnTypeNullable(index)
+}
+
+func (r *statsRows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	return r.wrapped.(driver.RowsColumnTypePrecisionScale).ColumnTypePrecisionScale(index)
+}
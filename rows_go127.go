@@ -0,0 +1,24 @@
+//go:build go1.27
+
+package dbstats
+
+import "database/sql/driver"
+
+// rowsColumnScanner holds the methods driver.RowsColumnScanner adds to driver.Rows. Like
+// the interfaces in rows.go, it is what wrapRows embeds instead of the driver interface.
+type rowsColumnScanner interface {
+	NextRow() error
+	ScanColumn(scanCtx driver.ScanContext, index int, dest interface{}) error
+}
+
+// NextRow is called by database/sql instead of Next when the wrapped rows implement
+// driver.RowsColumnScanner, so it reports the row the same way.
+func (r *statsRows) NextRow() error {
+	err := r.wrapped.(driver.RowsColumnScanner).NextRow()
+	r.iterated(err)
+	return err
+}
+
+func (r *statsRows) ScanColumn(scanCtx driver.ScanContext, index int, dest interface{}) error {
+	return r.wrapped.(driver.RowsColumnScanner).ScanColumn(scanCtx, index, dest)
+}
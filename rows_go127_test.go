@@ -0,0 +1,74 @@
+//go:build go1.27
+
+package dbstats
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+)
+
+func init() {
+	rowsAssertions = append(rowsAssertions,
+		assertion{"RowsColumnScanner", func(v interface{}) bool { _, ok := v.(driver.RowsColumnScanner); return ok }},
+	)
+}
+
+func (r *fakeFullRows) NextRow() error {
+	if r.rows > 0 {
+		return io.EOF
+	}
+	r.rows++
+	return nil
+}
+func (r *fakeFullRows) ScanColumn(scanCtx driver.ScanContext, index int, dest interface{}) error {
+	switch d := dest.(type) {
+	case *int64:
+		*d = 42
+	case *bool:
+		*d = false
+	}
+	return nil
+}
+
+// columnScannerConn returns rows that implement driver.RowsColumnScanner.
+type columnScannerConn struct{ fakeContextConn }
+
+func (c *columnScannerConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return newFakeRows(1<<uint(len(rowsAssertions)) - 1), nil
+}
+
+func TestRowsForwardsColumnScanner(t *testing.T) {
+	reset()
+	d := New(func(name string) (driver.Conn, error) { return &columnScannerConn{}, nil })
+	d.AddHook(hook)
+	c, _ := d.OpenConnector("")
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	rows, err := db.Query("SELECT c0, c1 FROM my_table")
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	var n int
+	var c0 int64
+	var c1 bool
+	for rows.Next() {
+		if err := rows.Scan(&c0, &c1); err != nil {
+			t.Fatalf("Scan returned error: %v", err)
+		}
+		n++
+	}
+	rows.Close()
+
+	switch {
+	case n != 1 || c0 != 42:
+		t.Errorf("Expected 1 row with c0=42, got %d rows and c0=%d", n, c0)
+	case hook.rowIteratedCount != 1:
+		t.Errorf("Expected RowIterated to be called 1 time, got %d", hook.rowIteratedCount)
+	case len(hook.rowsClosed) != 1 || hook.rowsClosed[0].rows != 1:
+		t.Errorf("Expected RowsClosed to count 1 row, got %+v", hook.rowsClosed)
+	}
+}
@@ -0,0 +1,236 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeColumnTypeRows implements every driver.RowsColumnType* interface. Column c0 is
+// a NOT NULL numeric(10,2) and column c1 is a nullable varchar(64).
+type fakeColumnTypeRows struct{ fakeRows }
+
+func (r *fakeColumnTypeRows) ColumnTypeScanType(index int) reflect.Type {
+	if index == 0 {
+		return reflect.TypeOf(float64(0))
+	}
+	return reflect.TypeOf("")
+}
+func (r *fakeColumnTypeRows) ColumnTypeDatabaseTypeName(index int) string {
+	if index == 0 {
+		return "NUMERIC"
+	}
+	return "VARCHAR"
+}
+func (r *fakeColumnTypeRows) ColumnTypeLength(index int) (length int64, ok bool) {
+	if index == 0 {
+		return 0, false
+	}
+	return 64, true
+}
+func (r *fakeColumnTypeRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	return index == 1, true
+}
+func (r *fakeColumnTypeRows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	if index == 0 {
+		return 10, 2, true
+	}
+	return 0, 0, false
+}
+
+// fakeMultiResultRows returns len(sets) result sets, the i-th of which has sets[i] rows.
+type fakeMultiResultRows struct {
+	sets     []int
+	set      int
+	row      int
+	closeErr error
+	delay    time.Duration // how long each call to Next takes
+}
+
+func (r *fakeMultiResultRows) Columns() []string {
+	return []string{"c0"}
+}
+func (r *fakeMultiResultRows) Close() error {
+	return r.closeErr
+}
+func (r *fakeMultiResultRows) Next(dest []driver.Value) error {
+	time.Sleep(r.delay)
+	if r.row >= r.sets[r.set] {
+		return io.EOF
+	}
+	dest[0] = int64(r.row)
+	r.row++
+	return nil
+}
+func (r *fakeMultiResultRows) HasNextResultSet() bool {
+	return r.set+1 < len(r.sets)
+}
+func (r *fakeMultiResultRows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+	r.set++
+	r.row = 0
+	return nil
+}
+
+func TestRowsForwardsMultipleResultSets(t *testing.T) {
+	reset()
+	useMultiResultRows = true
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	query := "CALL my_procedure()"
+	rows, err := db.QueryContext(context.Background(), query)
+	if err != nil {
+		t.Fatalf("QueryContext returned error: %v", err)
+	}
+	var counts []int
+	for {
+		n := 0
+		for rows.Next() {
+			n++
+		}
+		counts = append(counts, n)
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	rows.Close()
+
+	if !reflect.DeepEqual(counts, []int{1, 2}) {
+		t.Errorf("Expected to iterate result sets of 1 and 2 rows, got %v", counts)
+	}
+	want := []resultSetEvent{
+		{query: query, index: 0, rows: 1},
+		{query: query, index: 1, rows: 2},
+	}
+	if !reflect.DeepEqual(hook.resultSets, want) {
+		t.Errorf("Expected ResultSetDone events %+v, got %+v", want, hook.resultSets)
+	}
+}
+
+func TestRowsReportsSingleResultSet(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeStats", "")
+	defer db.Close()
+
+	stmt, _ := db.Prepare("SELECT c0, c1 FROM my_table WHERE myvar=?")
+	defer stmt.Close()
+	rows, err := stmt.Query(1)
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+
+	want := []resultSetEvent{{query: "SELECT c0, c1 FROM my_table WHERE myvar=?", index: 0, rows: 1}}
+	if !reflect.DeepEqual(hook.resultSets, want) {
+		t.Errorf("Expected ResultSetDone events %+v, got %+v", want, hook.resultSets)
+	}
+}
+
+func TestRowsForwardsColumnTypes(t *testing.T) {
+	reset()
+	useColumnTypeRows = true
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT c0, c1 FROM my_table")
+	if err != nil {
+		t.Fatalf("QueryContext returned error: %v", err)
+	}
+	defer rows.Close()
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatalf("ColumnTypes returned error: %v", err)
+	}
+	if len(cts) != 2 {
+		t.Fatalf("Expected 2 column types, got %d", len(cts))
+	}
+
+	if name := cts[0].DatabaseTypeName(); name != "NUMERIC" {
+		t.Errorf("Expected DatabaseTypeName NUMERIC, got %q", name)
+	}
+	if st := cts[0].ScanType(); st != reflect.TypeOf(float64(0)) {
+		t.Errorf("Expected ScanType float64, got %v", st)
+	}
+	if p, s, ok := cts[0].DecimalSize(); !ok || p != 10 || s != 2 {
+		t.Errorf("Expected DecimalSize 10, 2, true, got %d, %d, %t", p, s, ok)
+	}
+	if n, ok := cts[0].Nullable(); !ok || n {
+		t.Errorf("Expected Nullable false, true, got %t, %t", n, ok)
+	}
+	if l, ok := cts[1].Length(); !ok || l != 64 {
+		t.Errorf("Expected Length 64, true, got %d, %t", l, ok)
+	}
+	if n, ok := cts[1].Nullable(); !ok || !n {
+		t.Errorf("Expected Nullable true, true, got %t, %t", n, ok)
+	}
+}
+
+func TestRowsWithoutColumnTypes(t *testing.T) {
+	reset()
+	db, _ := sql.Open("fakeContextStats", "")
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT c0, c1 FROM my_table")
+	if err != nil {
+		t.Fatalf("QueryContext returned error: %v", err)
+	}
+	defer rows.Close()
+	cts, _ := rows.ColumnTypes()
+	if _, ok := cts[0].Length(); ok {
+		t.Errorf("Expected Length to be unavailable when the driver does not support it")
+	}
+	if name := cts[0].DatabaseTypeName(); name != "" {
+		t.Errorf("Expected empty DatabaseTypeName when the driver does not support it, got %q", name)
+	}
+}
+
+func TestRowsReportsRowsClosed(t *testing.T) {
+	reset()
+	d := newStatsDriver(nil)
+	d.AddHook(hook)
+	closeErr := errors.New("failed to close")
+	start := time.Now()
+	r := wrapRows(&statsRows{
+		d:       d,
+		ctx:     context.Background(),
+		start:   start,
+		query:   "CALL my_procedure()",
+		wrapped: &fakeMultiResultRows{sets: []int{2, 3}, closeErr: closeErr, delay: time.Millisecond},
+	})
+	dest := make([]driver.Value, 1)
+	for r.Next(dest) == nil {
+	}
+	r.(driver.RowsNextResultSet).NextResultSet()
+	for r.Next(dest) == nil {
+	}
+	err := r.Close()
+	elapsed := time.Now().Sub(start)
+
+	switch {
+	case err != closeErr:
+		t.Errorf("Expected Close to return the wrapped error, got %v", err)
+	case len(hook.rowsClosed) != 1:
+		t.Fatalf("Expected RowsClosed to be called 1 time, got %d", len(hook.rowsClosed))
+	}
+	e := hook.rowsClosed[0]
+	switch {
+	case e.query != "CALL my_procedure()":
+		t.Errorf("Expected RowsClosed to get the query, got %q", e.query)
+	case e.rows != 5:
+		t.Errorf("Expected RowsClosed to count 5 rows, got %d", e.rows)
+	case e.err != closeErr:
+		t.Errorf("Expected RowsClosed to get the close error, got %v", e.err)
+	case e.d < 5*time.Millisecond || e.d > elapsed:
+		t.Errorf("Expected RowsClosed duration to cover fetching the rows, got %v", e.d)
+	}
+}
@@ -0,0 +1,402 @@
+package dbstats
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SketchHook is a Hook that records the durations of queries, execs, transactions and
+// connection opens into Sketches. Unlike the fixed buckets of a HistogramHook, a Sketch
+// estimates every quantile to within a chosen relative error, which makes it suitable
+// for high percentiles of very short operations. Failed operations are recorded along
+// with successful ones.
+//
+// A Sketch has to be locked to record a duration, so to keep concurrent operations from
+// waiting on each other each of a SketchHook's Sketches is split into one shard per
+// processor. Durations are recorded into the shards in turn, and the shards are merged
+// when the Sketch is read.
+//
+// The zero value is ready to use and records with DefaultSketchAccuracy,
+// NewSketchHook creates a SketchHook with another accuracy.
+type SketchHook struct {
+	once    sync.Once // initialises the sketches of a zero value SketchHook
+	queries shardedSketch
+	execs   shardedSketch
+	txs     shardedSketch
+	opens   shardedSketch
+}
+
+// NewSketchHook returns a SketchHook whose Sketches estimate quantiles to within
+// relativeAccuracy, for example 0.01 for 1%. It panics if relativeAccuracy is not
+// between 0 and 1.
+func NewSketchHook(relativeAccuracy float64) *SketchHook {
+	NewSketch(relativeAccuracy) // checks relativeAccuracy
+	h := &SketchHook{}
+	h.once.Do(func() { h.init(relativeAccuracy) })
+	return h
+}
+
+func (h *SketchHook) init(accuracy float64) {
+	h.queries = newShardedSketch(accuracy)
+	h.execs = newShardedSketch(accuracy)
+	h.txs = newShardedSketch(accuracy)
+	h.opens = newShardedSketch(accuracy)
+}
+
+// sketches initialises h with DefaultSketchAccuracy if it was not created by
+// NewSketchHook, and returns it.
+func (h *SketchHook) sketches() *SketchHook {
+	h.once.Do(func() { h.init(DefaultSketchAccuracy) })
+	return h
+}
+
+// Queries returns a copy of the Sketch of query durations.
+func (h *SketchHook) Queries() *Sketch {
+	return h.sketches().queries.load()
+}
+
+// Execs returns a copy of the Sketch of exec durations.
+func (h *SketchHook) Execs() *Sketch {
+	return h.sketches().execs.load()
+}
+
+// Txs returns a copy of the Sketch of transaction durations, from the start of Begin
+// until Commit or Rollback returned.
+func (h *SketchHook) Txs() *Sketch {
+	return h.sketches().txs.load()
+}
+
+// ConnOpens returns a copy of the Sketch of connection open durations.
+func (h *SketchHook) ConnOpens() *Sketch {
+	return h.sketches().opens.load()
+}
+
+// Queried implements Queried of the Hook interface.
+func (h *SketchHook) Queried(d time.Duration, query string, err error) {
+	h.sketches().queries.add(d)
+}
+
+// Execed implements Execed of the Hook interface.
+func (h *SketchHook) Execed(d time.Duration, query string, err error) {
+	h.sketches().execs.add(d)
+}
+
+// TxEnded implements TxEnded of the TxSummaryHook interface.
+func (h *SketchHook) TxEnded(ctx context.Context, summary TxSummary, err error) {
+	h.sketches().txs.add(summary.Duration)
+}
+
+// ConnOpenedWithDuration implements ConnOpenedWithDuration of the ConnOpenHook
+// interface.
+func (h *SketchHook) ConnOpenedWithDuration(ctx context.Context, d time.Duration, err error) {
+	h.sketches().opens.add(d)
+}
+
+// ConnOpened implements ConnOpened of the Hook interface. Connection open durations
+// are recorded by ConnOpenedWithDuration instead.
+func (h *SketchHook) ConnOpened(err error) {}
+
+// ConnClosed implements ConnClosed of the Hook interface. Connections are not recorded.
+func (h *SketchHook) ConnClosed(err error) {}
+
+// StmtPrepared implements StmtPrepared of the Hook interface. Statements are not
+// recorded.
+func (h *SketchHook) StmtPrepared(query string, err error) {}
+
+// StmtClosed implements StmtClosed of the Hook interface. Statements are not recorded.
+func (h *SketchHook) StmtClosed(err error) {}
+
+// TxBegan implements TxBegan of the Hook interface. Transaction durations are recorded
+// by TxEnded instead.
+func (h *SketchHook) TxBegan(err error) {}
+
+// TxCommitted implements TxCommitted of the Hook interface. Transaction durations are
+// recorded by TxEnded instead.
+func (h *SketchHook) TxCommitted(err error) {}
+
+// TxRolledback implements TxRolledback of the Hook interface. Transaction durations are
+// recorded by TxEnded instead.
+func (h *SketchHook) TxRolledback(err error) {}
+
+// RowIterated implements RowIterated of the Hook interface. Rows are not recorded.
+func (h *SketchHook) RowIterated(err error) {}
+
+// shardedSketch spreads the durations recorded into a Sketch across several shards so
+// that concurrent recordings seldom wait for the same lock.
+type shardedSketch struct {
+	shards []*Sketch
+	next   uint32 // accessed atomically, the shard to record the next duration into
+}
+
+func newShardedSketch(accuracy float64) shardedSketch {
+	shards := make([]*Sketch, runtime.GOMAXPROCS(0))
+	for i := range shards {
+		shards[i] = NewSketch(accuracy)
+	}
+	return shardedSketch{shards: shards}
+}
+
+func (s *shardedSketch) add(d time.Duration) {
+	i := atomic.AddUint32(&s.next, 1) % uint32(len(s.shards))
+	s.shards[i].Add(d)
+}
+
+// load returns a Sketch holding the durations of every shard.
+func (s *shardedSketch) load() *Sketch {
+	m := s.shards[0].Clone()
+	for _, shard := range s.shards[1:] {
+		m.Merge(shard) // the shards all have the same accuracy
+	}
+	return m
+}
+
+// Sketch is a DDSketch of durations: a mergeable summary from which any quantile can be
+// estimated with a relative error no greater than the Sketch's accuracy. Durations are
+// counted in buckets whose bounds grow geometrically, so the memory used depends on
+// the ratio between the longest and shortest durations rather than on how many are
+// added. Durations of zero or less are counted together and estimated as zero.
+//
+// A Sketch is safe for concurrent use, though each call locks it. The zero value is an
+// empty Sketch with DefaultSketchAccuracy.
+type Sketch struct {
+	mu       sync.Mutex
+	accuracy float64
+	gamma    float64 // the ratio between the bounds of consecutive buckets
+	logGamma float64
+	zeros    uint64
+	buckets  map[int]uint64 // counts by bucket index, durations in bucket i are in (gamma^(i-1), gamma^i] nanoseconds
+}
+
+// ErrAccuracyMismatch is returned by Sketch.Merge when the Sketches being merged have
+// different accuracies.
+var ErrAccuracyMismatch = errors.New("dbstats: sketches have different accuracies")
+
+// ErrInvalidSketch is returned by Sketch.UnmarshalBinary when it is given data that was
+// not produced by Sketch.MarshalBinary.
+var ErrInvalidSketch = errors.New("dbstats: invalid sketch encoding")
+
+// DefaultSketchAccuracy is the relative accuracy of a zero value Sketch or SketchHook.
+const DefaultSketchAccuracy = 0.01
+
+// sketchVersion is the first byte of an encoded Sketch.
+const sketchVersion = 1
+
+// NewSketch returns an empty Sketch that estimates quantiles to within
+// relativeAccuracy. It panics if relativeAccuracy is not between 0 and 1.
+func NewSketch(relativeAccuracy float64) *Sketch {
+	if !(relativeAccuracy > 0 && relativeAccuracy < 1) {
+		panic("dbstats: sketch accuracy must be between 0 and 1")
+	}
+	s := &Sketch{}
+	s.init(relativeAccuracy)
+	return s
+}
+
+func (s *Sketch) init(accuracy float64) {
+	s.accuracy = accuracy
+	s.gamma = (1 + accuracy) / (1 - accuracy)
+	s.logGamma = math.Log(s.gamma)
+	s.zeros = 0
+	s.buckets = make(map[int]uint64)
+}
+
+// lock locks s, first initialising it with DefaultSketchAccuracy if it is a zero
+// value Sketch.
+func (s *Sketch) lock() {
+	s.mu.Lock()
+	if s.buckets == nil {
+		s.init(DefaultSketchAccuracy)
+	}
+}
+
+// Accuracy returns the relative accuracy the Sketch was created with.
+func (s *Sketch) Accuracy() float64 {
+	s.lock()
+	defer s.mu.Unlock()
+	return s.accuracy
+}
+
+// Add records d.
+func (s *Sketch) Add(d time.Duration) {
+	s.lock()
+	defer s.mu.Unlock()
+	if d <= 0 {
+		s.zeros++
+		return
+	}
+	s.buckets[int(math.Ceil(math.Log(float64(d))/s.logGamma))]++
+}
+
+// Count returns the number of durations recorded.
+func (s *Sketch) Count() uint64 {
+	s.lock()
+	defer s.mu.Unlock()
+	n := s.zeros
+	for _, c := range s.buckets {
+		n += c
+	}
+	return n
+}
+
+// Quantile estimates the q-quantile of the recorded durations, for example q = 0.999
+// for the 99.9th percentile. The estimate is within the Sketch's relative accuracy of
+// the recorded duration at rank q*(Count()-1), rounded down. Quantile returns zero if no
+// durations have been recorded.
+func (s *Sketch) Quantile(q float64) time.Duration {
+	s.lock()
+	defer s.mu.Unlock()
+	total := s.zeros
+	for _, c := range s.buckets {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	if q < 0 {
+		q = 0
+	} else if q > 1 {
+		q = 1
+	}
+	rank := uint64(q * float64(total-1))
+	if rank < s.zeros {
+		return 0
+	}
+	seen := s.zeros
+	indexes := s.indexes()
+	for _, i := range indexes {
+		seen += s.buckets[i]
+		if seen > rank {
+			return s.value(i)
+		}
+	}
+	return s.value(indexes[len(indexes)-1])
+}
+
+// value returns the estimate for durations in bucket i, which is within the accuracy
+// of both of the bucket's bounds.
+func (s *Sketch) value(i int) time.Duration {
+	return time.Duration(2 * math.Pow(s.gamma, float64(i)) / (s.gamma + 1))
+}
+
+// indexes returns the indexes of the non-empty buckets in increasing order. s.mu must
+// be held.
+func (s *Sketch) indexes() []int {
+	indexes := make([]int, 0, len(s.buckets))
+	for i := range s.buckets {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// Merge adds the durations recorded in o to s, for example to combine Sketches from
+// several processes. It returns ErrAccuracyMismatch if o was created with a different
+// accuracy.
+func (s *Sketch) Merge(o *Sketch) error {
+	o = o.Clone()
+	s.lock()
+	defer s.mu.Unlock()
+	if o.accuracy != s.accuracy {
+		return ErrAccuracyMismatch
+	}
+	s.zeros += o.zeros
+	for i, c := range o.buckets {
+		s.buckets[i] += c
+	}
+	return nil
+}
+
+// Clone returns a copy of s.
+func (s *Sketch) Clone() *Sketch {
+	s.lock()
+	defer s.mu.Unlock()
+	c := &Sketch{}
+	c.init(s.accuracy)
+	c.zeros = s.zeros
+	for i, n := range s.buckets {
+		c.buckets[i] = n
+	}
+	return c
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding holds the accuracy
+// and the count of each non-empty bucket.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	s.lock()
+	defer s.mu.Unlock()
+	b := make([]byte, 0, 1+8+binary.MaxVarintLen64*(2+2*len(s.buckets)))
+	b = append(b, sketchVersion)
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(s.accuracy))
+	b = binary.AppendUvarint(b, s.zeros)
+	b = binary.AppendUvarint(b, uint64(len(s.buckets)))
+	prev := 0
+	for _, i := range s.indexes() {
+		// Bucket indexes are stored as the difference from the previous one, which
+		// keeps them short as the buckets of a Sketch are usually close together.
+		b = binary.AppendVarint(b, int64(i-prev))
+		b = binary.AppendUvarint(b, s.buckets[i])
+		prev = i
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of s
+// with the Sketch encoded in data by MarshalBinary.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	if len(data) < 9 || data[0] != sketchVersion {
+		return ErrInvalidSketch
+	}
+	accuracy := math.Float64frombits(binary.BigEndian.Uint64(data[1:9]))
+	if !(accuracy > 0 && accuracy < 1) {
+		return ErrInvalidSketch
+	}
+	data = data[9:]
+	next := func() (uint64, bool) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, false
+		}
+		data = data[n:]
+		return v, true
+	}
+	zeros, ok := next()
+	if !ok {
+		return ErrInvalidSketch
+	}
+	n, ok := next()
+	if !ok || n > uint64(len(data)) {
+		return ErrInvalidSketch
+	}
+	buckets := make(map[int]uint64, n)
+	i := 0
+	for ; n > 0; n-- {
+		delta, l := binary.Varint(data)
+		if l <= 0 {
+			return ErrInvalidSketch
+		}
+		data = data[l:]
+		count, ok := next()
+		if !ok {
+			return ErrInvalidSketch
+		}
+		i += int(delta)
+		buckets[i] += count
+	}
+	if len(data) != 0 {
+		return ErrInvalidSketch
+	}
+
+	s.lock()
+	defer s.mu.Unlock()
+	s.init(accuracy)
+	s.zeros = zeros
+	s.buckets = buckets
+	return nil
+}
@@ -0,0 +1,222 @@
+package dbstats
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lognormalDurations returns n durations spread over several orders of magnitude
+// around median, like the latencies of real queries.
+func lognormalDurations(r *rand.Rand, n int, median time.Duration) []time.Duration {
+	ds := make([]time.Duration, n)
+	for i := range ds {
+		ds[i] = time.Duration(float64(median) * math.Exp(r.NormFloat64()*1.5))
+	}
+	return ds
+}
+
+// checkQuantiles checks that s estimates the quantiles of ds to within its accuracy.
+func checkQuantiles(t *testing.T, s *Sketch, ds []time.Duration) {
+	t.Helper()
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for _, q := range []float64{0, 0.25, 0.5, 0.9, 0.95, 0.99, 0.999, 1} {
+		exact := sorted[int(q*float64(len(sorted)-1))]
+		got := s.Quantile(q)
+		relErr := math.Abs(float64(got-exact)) / float64(exact)
+		// Allow for rounding the estimate to a whole nanosecond.
+		if relErr > s.Accuracy()+1/float64(exact) {
+			t.Errorf("Quantile(%v): expected %v to within %v, got %v (relative error %.4f)", q, exact, s.Accuracy(), got, relErr)
+		}
+	}
+}
+
+func TestSketchErrorBounds(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, accuracy := range []float64{0.05, 0.01, 0.001} {
+		for _, median := range []time.Duration{50 * time.Microsecond, 20 * time.Millisecond} {
+			s := NewSketch(accuracy)
+			ds := lognormalDurations(r, 10000, median)
+			for _, d := range ds {
+				s.Add(d)
+			}
+			if s.Count() != uint64(len(ds)) {
+				t.Errorf("Expected Count %d, got %d", len(ds), s.Count())
+			}
+			checkQuantiles(t, s, ds)
+		}
+	}
+}
+
+func TestSketchMergeAndEncode(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	// Sketches from several processes, encoded to be merged elsewhere.
+	var all []time.Duration
+	var encoded [][]byte
+	for p := 0; p < 3; p++ {
+		s := NewSketch(0.01)
+		ds := lognormalDurations(r, 5000, time.Duration(p+1)*time.Millisecond)
+		for _, d := range ds {
+			s.Add(d)
+		}
+		all = append(all, ds...)
+		b, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary returned error: %v", err)
+		}
+		encoded = append(encoded, b)
+	}
+
+	merged := NewSketch(0.01)
+	for _, b := range encoded {
+		var s Sketch
+		if err := s.UnmarshalBinary(b); err != nil {
+			t.Fatalf("UnmarshalBinary returned error: %v", err)
+		}
+		if err := merged.Merge(&s); err != nil {
+			t.Fatalf("Merge returned error: %v", err)
+		}
+	}
+	if merged.Count() != uint64(len(all)) {
+		t.Errorf("Expected merged Count %d, got %d", len(all), merged.Count())
+	}
+	checkQuantiles(t, merged, all)
+
+	if err := merged.Merge(NewSketch(0.05)); err != ErrAccuracyMismatch {
+		t.Errorf("Expected ErrAccuracyMismatch, got %v", err)
+	}
+}
+
+func TestSketchCountsNonPositiveDurationsAsZero(t *testing.T) {
+	s := NewSketch(0.01)
+	s.Add(0)
+	s.Add(-time.Second)
+	s.Add(time.Millisecond)
+
+	switch {
+	case s.Count() != 3:
+		t.Errorf("Expected Count 3, got %d", s.Count())
+	case s.Quantile(0.5) != 0:
+		t.Errorf("Expected the median to be 0, got %v", s.Quantile(0.5))
+	case s.Quantile(1) == 0:
+		t.Errorf("Expected the maximum to be about 1ms, got 0")
+	case NewSketch(0.01).Quantile(0.5) != 0:
+		t.Errorf("Expected the quantile of an empty sketch to be 0")
+	}
+}
+
+func TestSketchUnmarshalRejectsInvalidData(t *testing.T) {
+	s := NewSketch(0.01)
+	s.Add(time.Millisecond)
+	valid, _ := s.MarshalBinary()
+
+	for name, data := range map[string][]byte{
+		"empty":     nil,
+		"version":   append([]byte{0}, valid[1:]...),
+		"truncated": valid[:len(valid)-1],
+		"trailing":  append(append([]byte(nil), valid...), 0),
+	} {
+		if err := new(Sketch).UnmarshalBinary(data); err != ErrInvalidSketch {
+			t.Errorf("%s: expected ErrInvalidSketch, got %v", name, err)
+		}
+	}
+}
+
+func TestSketchHook(t *testing.T) {
+	h := NewSketchHook(0.01)
+	ctx := context.Background()
+	h.Queried(time.Millisecond, "SELECT 1", nil)
+	h.Queried(3*time.Millisecond, "SELECT 1", anErr)
+	h.Execed(time.Second, "UPDATE t SET a=1", nil)
+	h.TxEnded(ctx, TxSummary{Duration: time.Minute}, nil)
+	h.ConnOpenedWithDuration(ctx, 10*time.Millisecond, nil)
+
+	within := func(got, want time.Duration) bool {
+		return math.Abs(float64(got-want)) <= 0.01*float64(want)
+	}
+	switch q := h.Queries(); {
+	case q.Count() != 2:
+		t.Errorf("Expected 2 queries, got %d", q.Count())
+	case !within(q.Quantile(1), 3*time.Millisecond):
+		t.Errorf("Expected the slowest query to be about 3ms, got %v", q.Quantile(1))
+	}
+	if got := h.Execs().Quantile(0.5); !within(got, time.Second) {
+		t.Errorf("Expected the median exec to be about 1s, got %v", got)
+	}
+	if got := h.Txs().Quantile(0.5); !within(got, time.Minute) {
+		t.Errorf("Expected the median transaction to be about 1m, got %v", got)
+	}
+	if got := h.ConnOpens().Quantile(0.5); !within(got, 10*time.Millisecond) {
+		t.Errorf("Expected the median open to be about 10ms, got %v", got)
+	}
+
+	// The accessors return copies that are not affected by later events.
+	execs := h.Execs()
+	h.Execed(time.Second, "UPDATE t SET a=1", nil)
+	if execs.Count() != 1 {
+		t.Errorf("Expected copy to keep 1 exec, got %d", execs.Count())
+	}
+}
+
+func TestSketchZeroValue(t *testing.T) {
+	var s Sketch
+	s.Add(time.Millisecond)
+
+	switch {
+	case s.Accuracy() != DefaultSketchAccuracy:
+		t.Errorf("Expected the zero value to have accuracy %v, got %v", DefaultSketchAccuracy, s.Accuracy())
+	case s.Count() != 1:
+		t.Errorf("Expected Count 1, got %d", s.Count())
+	case s.Merge(NewSketch(DefaultSketchAccuracy)) != nil:
+		t.Errorf("Expected a zero value Sketch to merge with the default accuracy")
+	}
+	var empty Sketch
+	if err := s.Merge(&empty); err != nil || s.Count() != 1 {
+		t.Errorf("Expected merging an empty zero value Sketch to change nothing, got %v", err)
+	}
+}
+
+func TestSketchHookZeroValue(t *testing.T) {
+	var h SketchHook
+	h.Queried(time.Millisecond, "SELECT 1", nil)
+	h.TxEnded(context.Background(), TxSummary{Duration: time.Second}, nil)
+
+	q := h.Queries()
+	switch {
+	case q.Accuracy() != DefaultSketchAccuracy:
+		t.Errorf("Expected the zero value to have accuracy %v, got %v", DefaultSketchAccuracy, q.Accuracy())
+	case q.Count() != 1 || h.Txs().Count() != 1:
+		t.Errorf("Expected 1 query and 1 transaction, got %d and %d", q.Count(), h.Txs().Count())
+	case h.Execs().Count() != 0:
+		t.Errorf("Expected no execs, got %d", h.Execs().Count())
+	}
+}
+
+func TestSketchHookConcurrent(t *testing.T) {
+	h := NewSketchHook(0.01)
+	const workers, queries = 8, 1000
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 1; j <= queries; j++ {
+				h.Queried(time.Duration(j)*time.Microsecond, "SELECT 1", nil)
+			}
+		}()
+	}
+	wg.Wait()
+
+	q := h.Queries()
+	if q.Count() != workers*queries {
+		t.Errorf("Expected %d queries across the shards, got %d", workers*queries, q.Count())
+	}
+	if got := q.Quantile(1); math.Abs(float64(got-time.Millisecond)) > 0.01*float64(time.Millisecond) {
+		t.Errorf("Expected the slowest query to be about 1ms, got %v", got)
+	}
+}
@@ -0,0 +1,83 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+// columnConverter is driver.ColumnConverter under a name that does not clash with its
+// method. An embedded driver.ColumnConverter field would be named ColumnConverter and
+// hide the method it is meant to promote.
+type columnConverter interface {
+	ColumnConverter(idx int) driver.ValueConverter
+}
+
+// statsStmt implements every optional statement interface that database/sql checks
+// for. wrapStmt is used to expose only the interfaces the wrapped statement implements.
+type statsStmt struct {
+	d       *statsDriver
+	conn    *statsConn // the connection the statement was prepared on
+	wrapped driver.Stmt
+	query   string
+}
+
+func (s *statsStmt) Close() error {
+	err := s.wrapped.Close()
+	s.d.StmtClosed(s.conn.context(context.Background()), err)
+	return err
+}
+
+func (s *statsStmt) NumInput() int {
+	return s.wrapped.NumInput()
+}
+
+func (s *statsStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), valueToNamedValue(args))
+}
+
+func (s *statsStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	res, err := s.d.run(ctx, &Op{Kind: OpStmtExec, Query: s.query, Args: args, stmt: s})
+	return res.Result, err
+}
+
+func (s *statsStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), valueToNamedValue(args))
+}
+
+func (s *statsStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	res, err := s.d.run(ctx, &Op{Kind: OpStmtQuery, Query: s.query, Args: args, stmt: s})
+	return res.Rows, err
+}
+
+func (s *statsStmt) ColumnConverter(idx int) driver.ValueConverter {
+	return s.wrapped.(driver.ColumnConverter).ColumnConverter(idx)
+}
+
+func (s *statsStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	return s.wrapped.(driver.NamedValueChecker).CheckNamedValue(nv)
+}
+
+// runQuery and runExec pass operations to the wrapped statement once they have been through
+// the interceptor chain, preferring the context aware interfaces.
+
+func (s *statsStmt) runQuery(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if sqc, ok := s.wrapped.(driver.StmtQueryContext); ok {
+		return sqc.QueryContext(ctx, args)
+	}
+	dargs, err := namedValueToValue(args)
+	if err != nil {
+		return nil, err
+	}
+	return s.wrapped.Query(dargs)
+}
+
+func (s *statsStmt) runExec(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if sec, ok := s.wrapped.(driver.StmtExecContext); ok {
+		return sec.ExecContext(ctx, args)
+	}
+	dargs, err := namedValueToValue(args)
+	if err != nil {
+		return nil, err
+	}
+	return s.wrapped.Exec(dargs)
+}
@@ -0,0 +1,72 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"time"
+)
+
+// statsTx wraps a driver.Tx. database/sql does not check transactions for any optional
+// interfaces, so unlike the other wrappers it is returned as is.
+type statsTx struct {
+	d       *statsDriver
+	conn    *statsConn      // the connection the transaction was begun on
+	ctx     context.Context // the context the transaction was begun with
+	fs      finishers       // the Finishers to call when the transaction ends
+	wrapped driver.Tx
+
+	// The fields below are only accessed while database/sql holds the connection for
+	// the transaction, so need no locking.
+	start        time.Time // when Begin was called
+	ended        bool      // whether the wrapped transaction has been committed or rolled back
+	queries      int
+	execs        int
+	rowsAffected int64
+}
+
+func (t *statsTx) Commit() error {
+	return t.end(OpCommit)
+}
+
+func (t *statsTx) Rollback() error {
+	return t.end(OpRollback)
+}
+
+// end runs an OpCommit or OpRollback. If the interceptor chain finishes it without
+// passing it to the wrapped transaction, the wrapped transaction is rolled back, as
+// database/sql considers the transaction done either way and would otherwise return the
+// connection to the pool with the transaction still open. An error from that rollback is
+// joined to the error returned.
+func (t *statsTx) end(kind OpKind) error {
+	_, err := t.d.run(t.ctx, &Op{Kind: kind, tx: t})
+	if !t.ended {
+		t.ended = true
+		if rerr := t.wrapped.Rollback(); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+	}
+	return err
+}
+
+// execed records an exec run in the transaction.
+func (t *statsTx) execed(r driver.Result, err error) {
+	t.execs++
+	if err != nil || len(t.d.loadHooks().txHooks) == 0 {
+		return
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		t.rowsAffected += n
+	}
+}
+
+// summary summarises the transaction as it ends.
+func (t *statsTx) summary(committed bool) TxSummary {
+	return TxSummary{
+		Duration:     time.Now().Sub(t.start),
+		Queries:      t.queries,
+		Execs:        t.execs,
+		RowsAffected: t.rowsAffected,
+		Committed:    committed,
+	}
+}
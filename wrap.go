@@ -0,0 +1,18 @@
+package dbstats
+
+//go:generate go run wrap_gen.go
+
+// database/sql discovers what a driver supports by checking its connections,
+// statements and rows for optional interfaces. The wrappers must therefore implement
+// exactly the optional interfaces implemented by the values they wrap: exposing one
+// the wrapped value lacks changes how database/sql talks to the driver, and hiding
+// one that it implements loses functionality.
+//
+// statsConn, statsStmt and statsRows implement every optional interface. The
+// generated wrapConn, wrapStmt and wrapRows functions then return them embedded in an
+// anonymous struct whose embedded interfaces are the optional interfaces the wrapped
+// value implements, so only those methods are promoted. To support a new optional
+// interface, implement it on the stats type and add it to the table in wrap_gen.go.
+// An interface that only exists from some Go release on, like driver.RowsColumnScanner,
+// is listed with its build constraint and implemented in a file with the same
+// constraint, such as rows_go127.go.
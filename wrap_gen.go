@@ -0,0 +1,222 @@
+//go:build ignore
+
+// wrap_gen.go generates wrap_generated.go and wrap_generated_test.go. Wrappers that
+// forward an interface only available from some Go release on are generated into their
+// own pair of files for each side of the build constraint instead. Run it with go
+// generate.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// iface is an optional interface database/sql may check for.
+type iface struct {
+	Const string // the name of the mask bit for the interface
+	Type  string // the interface type
+	Build string // the build constraint the interface needs, if any
+}
+
+// wrapper describes one of the stats types and the optional interfaces it forwards.
+type wrapper struct {
+	Func     string // the name of the generated wrap function
+	Stats    string // the stats type that implements every optional interface
+	Base     string // the interface every wrapped value implements
+	Fake     string // the name of the generated test fake constructor
+	FakeFull string // the test type that implements every optional interface
+	Ifaces   []iface
+}
+
+var wrappers = []wrapper{
+	{
+		Func:     "wrapConn",
+		Stats:    "statsConn",
+		Base:     "driver.Conn",
+		Fake:     "newFakeConn",
+		FakeFull: "fakeFullConn",
+		Ifaces: []iface{
+			{"connQueryer", "driver.Queryer", ""},
+			{"connQueryerContext", "driver.QueryerContext", ""},
+			{"connExecer", "driver.Execer", ""},
+			{"connExecerContext", "driver.ExecerContext", ""},
+			{"connPrepareContext", "driver.ConnPrepareContext", ""},
+			{"connBeginTx", "driver.ConnBeginTx", ""},
+			{"connPinger", "driver.Pinger", ""},
+			{"connSessionResetter", "driver.SessionResetter", ""},
+			{"connValidator", "driver.Validator", ""},
+			{"connNamedValueChecker", "driver.NamedValueChecker", ""},
+		},
+	},
+	{
+		Func:     "wrapStmt",
+		Stats:    "statsStmt",
+		Base:     "driver.Stmt",
+		Fake:     "newFakeStmt",
+		FakeFull: "fakeFullStmt",
+		Ifaces: []iface{
+			{"stmtColumnConverter", "columnConverter", ""},
+			{"stmtNamedValueChecker", "driver.NamedValueChecker", ""},
+			{"stmtExecContext", "driver.StmtExecContext", ""},
+			{"stmtQueryContext", "driver.StmtQueryContext", ""},
+		},
+	},
+	{
+		Func:     "wrapRows",
+		Stats:    "statsRows",
+		Base:     "driver.Rows",
+		Fake:     "newFakeRows",
+		FakeFull: "fakeFullRows",
+		Ifaces: []iface{
+			{"rowsScanType", "rowsColumnTypeScanType", ""},
+			{"rowsDatabaseTypeName", "rowsColumnTypeDatabaseTypeName", ""},
+			{"rowsLength", "rowsColumnTypeLength", ""},
+			{"rowsNullable", "rowsColumnTypeNullable", ""},
+			{"rowsPrecisionScale", "rowsColumnTypePrecisionScale", ""},
+			{"rowsResultSets", "rowsNextResultSet", ""},
+			{"rowsScanColumn", "rowsColumnScanner", "go1.27"},
+		},
+	},
+}
+
+// build returns the build constraint needed by w's interfaces. A wrapper may only
+// forward interfaces needing one constraint.
+func (w wrapper) build() string {
+	for _, in := range w.Ifaces {
+		if in.Build != "" {
+			return in.Build
+		}
+	}
+	return ""
+}
+
+// without returns w without the interfaces that need a build constraint.
+func (w wrapper) without() wrapper {
+	var ifaces []iface
+	for _, in := range w.Ifaces {
+		if in.Build == "" {
+			ifaces = append(ifaces, in)
+		}
+	}
+	w.Ifaces = ifaces
+	return w
+}
+
+// combo is one combination of a wrapper's optional interfaces.
+type combo struct {
+	Mask   int
+	Ifaces []iface
+}
+
+func (w wrapper) Combos() []combo {
+	combos := make([]combo, 1<<uint(len(w.Ifaces)))
+	for mask := range combos {
+		combos[mask].Mask = mask
+		for i, in := range w.Ifaces {
+			if mask&(1<<uint(i)) != 0 {
+				combos[mask].Ifaces = append(combos[mask].Ifaces, in)
+			}
+		}
+	}
+	return combos
+}
+
+var wrapTmpl = template.Must(template.New("wrap").Parse(`// Code generated by wrap_gen.go; DO NOT EDIT.
+{{if .Build}}
+//go:build {{.Build}}
+{{end}}
+package dbstats
+
+import "database/sql/driver"
+{{range .Wrappers}}{{if .Ifaces}}
+const (
+{{- range $i, $in := .Ifaces}}
+	{{$in.Const}}{{if eq $i 0}} = 1 << iota{{end}}
+{{- end}}
+)
+{{end}}
+// {{.Func}} returns w as a {{.Base}} that implements exactly the optional interfaces
+// that w.wrapped implements.
+func {{.Func}}(w *{{.Stats}}) {{.Base}} {
+	var mask int
+{{- range .Ifaces}}
+	if _, ok := w.wrapped.({{.Type}}); ok {
+		mask |= {{.Const}}
+	}
+{{- end}}
+	switch mask {
+{{- $base := .Base}}{{range .Combos}}
+	case {{.Mask}}:
+		return struct{ {{$base}}{{range .Ifaces}}; {{.Type}}{{end}} }{w{{range .Ifaces}}, w{{end}}}
+{{- end}}
+	}
+	panic("dbstats: unreachable")
+}
+{{end}}`))
+
+var fakeTmpl = template.Must(template.New("fake").Parse(`// Code generated by wrap_gen.go; DO NOT EDIT.
+{{if .Build}}
+//go:build {{.Build}}
+{{end}}
+package dbstats
+
+import "database/sql/driver"
+{{range .Wrappers}}
+// {{.Fake}} returns a {{.Base}} that implements the optional interfaces selected by
+// mask, and only those.
+func {{.Fake}}(mask int) {{.Base}} {
+	f := &{{.FakeFull}}{}
+	switch mask {
+{{- $base := .Base}}{{range .Combos}}
+	case {{.Mask}}:
+		return struct{ {{$base}}{{range .Ifaces}}; {{.Type}}{{end}} }{f{{range .Ifaces}}, f{{end}}}
+{{- end}}
+	}
+	panic("dbstats: invalid mask")
+}
+{{end}}`))
+
+// file is the set of wrappers generated into one pair of files.
+type file struct {
+	Name     string // the name of the file without the .go suffix
+	Build    string // the build constraint of the file, if any
+	Wrappers []wrapper
+}
+
+func main() {
+	files := []file{{Name: "wrap_generated"}}
+	for _, w := range wrappers {
+		build := w.build()
+		if build == "" {
+			files[0].Wrappers = append(files[0].Wrappers, w)
+			continue
+		}
+		name := "wrap_" + strings.ToLower(strings.TrimPrefix(w.Stats, "stats"))
+		files = append(files,
+			file{Name: name + "_generated", Build: "!" + build, Wrappers: []wrapper{w.without()}},
+			file{Name: name + "_" + strings.ReplaceAll(build, ".", "") + "_generated", Build: build, Wrappers: []wrapper{w}},
+		)
+	}
+	for _, f := range files {
+		write(f.Name+".go", wrapTmpl, f)
+		write(f.Name+"_test.go", fakeTmpl, f)
+	}
+}
+
+func write(filename string, t *template.Template, f file) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, f); err != nil {
+		log.Fatalf("executing template for %s: %v", filename, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting %s: %v\n%s", filename, err, buf.Bytes())
+	}
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,9393 @@
+// Code generated by wrap_gen.go; DO NOT EDIT.
+
+package dbstats
+
+import "database/sql/driver"
+
+const (
+	connQueryer = 1 << iota
+	connQueryerContext
+	connExecer
+	connExecerContext
+	connPrepareContext
+	connBeginTx
+	connPinger
+	connSessionResetter
+	connValidator
+	connNamedValueChecker
+)
+
+// wrapConn returns w as a driver.Conn that implements exactly the optional interfaces
+// that w.wrapped implements.
+func wrapConn(w *statsConn) driver.Conn {
+	var mask int
+	if _, ok := w.wrapped.(driver.Queryer); ok {
+		mask |= connQueryer
+	}
+	if _, ok := w.wrapped.(driver.QueryerContext); ok {
+		mask |= connQueryerContext
+	}
+	if _, ok := w.wrapped.(driver.Execer); ok {
+		mask |= connExecer
+	}
+	if _, ok := w.wrapped.(driver.ExecerContext); ok {
+		mask |= connExecerContext
+	}
+	if _, ok := w.wrapped.(driver.ConnPrepareContext); ok {
+		mask |= connPrepareContext
+	}
+	if _, ok := w.wrapped.(driver.ConnBeginTx); ok {
+		mask |= connBeginTx
+	}
+	if _, ok := w.wrapped.(driver.Pinger); ok {
+		mask |= connPinger
+	}
+	if _, ok := w.wrapped.(driver.SessionResetter); ok {
+		mask |= connSessionResetter
+	}
+	if _, ok := w.wrapped.(driver.Validator); ok {
+		mask |= connValidator
+	}
+	if _, ok := w.wrapped.(driver.NamedValueChecker); ok {
+		mask |= connNamedValueChecker
+	}
+	switch mask {
+	case 0:
+		return struct{ driver.Conn }{w}
+	case 1:
+		return struct {
+			driver.Conn
+			driver.Queryer
+		}{w, w}
+	case 2:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+		}{w, w}
+	case 3:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w}
+	case 4:
+		return struct {
+			driver.Conn
+			driver.Execer
+		}{w, w}
+	case 5:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+		}{w, w, w}
+	case 6:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+		}{w, w, w}
+	case 7:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+		}{w, w, w, w}
+	case 8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+		}{w, w}
+	case 9:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+		}{w, w, w}
+	case 10:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+		}{w, w, w}
+	case 11:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+		}{w, w, w, w}
+	case 12:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w}
+	case 13:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w, w}
+	case 14:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w, w}
+	case 15:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w, w, w}
+	case 16:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+		}{w, w}
+	case 17:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+		}{w, w, w}
+	case 18:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+		}{w, w, w}
+	case 19:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w}
+	case 20:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+		}{w, w, w}
+	case 21:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+		}{w, w, w, w}
+	case 22:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+		}{w, w, w, w}
+	case 23:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+		}{w, w, w, w, w}
+	case 24:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w}
+	case 25:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w}
+	case 26:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w}
+	case 27:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w, w}
+	case 28:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w}
+	case 29:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w, w}
+	case 30:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w, w}
+	case 31:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{w, w, w, w, w, w}
+	case 32:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+		}{w, w}
+	case 33:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+		}{w, w, w}
+	case 34:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+		}{w, w, w}
+	case 35:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 36:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+		}{w, w, w}
+	case 37:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 38:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 39:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 40:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w}
+	case 41:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 42:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 43:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 44:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 45:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 46:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 47:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w, w}
+	case 48:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w}
+	case 49:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 50:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 51:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 52:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 53:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 54:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 55:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w, w}
+	case 56:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w}
+	case 57:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 58:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 59:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w, w}
+	case 60:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w}
+	case 61:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w, w}
+	case 62:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w, w}
+	case 63:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{w, w, w, w, w, w, w}
+	case 64:
+		return struct {
+			driver.Conn
+			driver.Pinger
+		}{w, w}
+	case 65:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w}
+	case 66:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w}
+	case 67:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 68:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+		}{w, w, w}
+	case 69:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+		}{w, w, w, w}
+	case 70:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+		}{w, w, w, w}
+	case 71:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 72:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w}
+	case 73:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 74:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 75:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 76:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 77:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 78:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 79:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 80:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w}
+	case 81:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 82:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 83:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 84:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 85:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 86:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 87:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 88:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 89:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 90:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 91:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 92:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 93:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 94:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 95:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 96:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w}
+	case 97:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w}
+	case 98:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w}
+	case 99:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 100:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w}
+	case 101:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 102:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 103:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 104:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w}
+	case 105:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 106:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 107:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 108:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 109:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 110:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 111:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 112:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w}
+	case 113:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 114:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 115:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 116:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 117:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 118:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 119:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 120:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 121:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 122:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 123:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 124:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 125:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 126:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 127:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w, w, w, w, w, w}
+	case 128:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+		}{w, w}
+	case 129:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w}
+	case 130:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w}
+	case 131:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 132:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w}
+	case 133:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 134:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 135:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 136:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w}
+	case 137:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 138:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 139:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 140:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 141:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 142:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 143:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 144:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w}
+	case 145:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 146:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 147:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 148:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 149:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 150:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 151:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 152:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 153:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 154:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 155:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 156:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 157:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 158:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 159:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 160:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w}
+	case 161:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 162:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 163:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 164:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 165:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 166:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 167:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 168:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 169:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 170:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 171:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 172:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 173:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 174:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 175:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 176:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 177:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 178:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 179:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 180:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 181:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 182:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 183:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 184:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 185:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 186:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 187:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 188:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 189:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 190:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 191:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 192:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w}
+	case 193:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 194:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 195:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 196:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 197:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 198:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 199:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 200:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 201:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 202:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 203:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 204:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 205:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 206:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 207:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 208:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 209:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 210:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 211:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 212:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 213:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 214:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 215:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 216:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 217:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 218:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 219:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 220:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 221:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 222:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 223:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 224:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 225:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 226:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 227:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 228:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 229:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 230:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 231:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 232:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 233:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 234:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 235:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 236:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 237:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 238:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 239:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 240:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 241:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 242:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 243:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 244:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 245:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 246:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 247:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 248:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 249:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 250:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 251:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 252:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 253:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 254:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 255:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w, w}
+	case 256:
+		return struct {
+			driver.Conn
+			driver.Validator
+		}{w, w}
+	case 257:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Validator
+		}{w, w, w}
+	case 258:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w}
+	case 259:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 260:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Validator
+		}{w, w, w}
+	case 261:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Validator
+		}{w, w, w, w}
+	case 262:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+		}{w, w, w, w}
+	case 263:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 264:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w}
+	case 265:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 266:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 267:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 268:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 269:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 270:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 271:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 272:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w}
+	case 273:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w}
+	case 274:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w}
+	case 275:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 276:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w}
+	case 277:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 278:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 279:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 280:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w}
+	case 281:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 282:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 283:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 284:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 285:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 286:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 287:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 288:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w}
+	case 289:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w}
+	case 290:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w}
+	case 291:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 292:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w}
+	case 293:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 294:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 295:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 296:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w}
+	case 297:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 298:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 299:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 300:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 301:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 302:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 303:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 304:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w}
+	case 305:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 306:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 307:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 308:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 309:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 310:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 311:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 312:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w}
+	case 313:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 314:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 315:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 316:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 317:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 318:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 319:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 320:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.Validator
+		}{w, w, w}
+	case 321:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 322:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 323:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 324:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 325:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 326:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 327:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 328:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 329:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 330:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 331:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 332:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 333:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 334:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 335:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 336:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 337:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 338:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 339:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 340:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 341:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 342:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 343:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 344:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 345:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 346:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 347:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 348:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 349:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 350:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 351:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 352:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 353:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 354:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 355:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 356:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 357:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 358:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 359:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 360:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 361:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 362:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 363:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 364:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 365:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 366:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 367:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 368:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 369:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 370:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 371:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 372:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 373:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 374:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 375:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 376:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 377:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 378:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 379:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 380:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 381:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 382:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 383:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 384:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w}
+	case 385:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 386:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 387:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 388:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 389:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 390:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 391:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 392:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 393:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 394:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 395:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 396:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 397:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 398:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 399:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 400:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 401:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 402:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 403:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 404:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 405:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 406:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 407:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 408:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 409:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 410:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 411:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 412:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 413:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 414:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 415:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 416:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 417:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 418:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 419:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 420:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 421:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 422:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 423:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 424:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 425:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 426:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 427:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 428:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 429:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 430:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 431:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 432:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 433:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 434:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 435:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 436:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 437:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 438:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 439:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 440:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 441:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 442:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 443:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 444:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 445:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 446:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 447:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 448:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 449:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 450:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 451:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 452:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 453:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 454:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 455:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 456:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 457:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 458:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 459:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 460:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 461:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 462:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 463:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 464:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 465:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 466:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 467:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 468:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 469:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 470:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 471:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 472:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 473:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 474:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 475:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 476:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 477:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 478:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 479:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 480:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 481:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 482:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 483:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 484:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 485:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 486:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 487:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 488:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 489:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 490:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 491:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 492:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 493:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 494:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 495:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 496:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 497:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 498:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 499:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 500:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 501:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 502:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 503:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 504:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 505:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 506:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 507:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 508:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 509:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 510:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 511:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 512:
+		return struct {
+			driver.Conn
+			driver.NamedValueChecker
+		}{w, w}
+	case 513:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 514:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 515:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 516:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 517:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 518:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 519:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 520:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 521:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 522:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 523:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 524:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 525:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 526:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 527:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 528:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 529:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 530:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 531:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 532:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 533:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 534:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 535:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 536:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 537:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 538:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 539:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 540:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 541:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 542:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 543:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 544:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 545:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 546:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 547:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 548:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 549:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 550:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 551:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 552:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 553:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 554:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 555:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 556:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 557:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 558:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 559:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 560:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 561:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 562:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 563:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 564:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 565:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 566:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 567:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 568:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 569:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 570:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 571:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 572:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 573:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 574:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 575:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 576:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 577:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 578:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 579:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 580:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 581:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 582:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 583:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 584:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 585:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 586:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 587:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 588:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 589:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 590:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 591:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 592:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 593:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 594:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 595:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 596:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 597:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 598:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 599:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 600:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 601:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 602:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 603:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 604:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 605:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 606:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 607:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 608:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 609:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 610:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 611:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 612:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 613:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 614:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 615:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 616:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 617:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 618:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 619:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 620:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 621:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 622:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 623:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 624:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 625:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 626:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 627:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 628:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 629:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 630:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 631:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 632:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 633:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 634:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 635:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 636:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 637:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 638:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 639:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 640:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 641:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 642:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 643:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 644:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 645:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 646:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 647:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 648:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 649:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 650:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 651:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 652:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 653:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 654:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 655:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 656:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 657:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 658:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 659:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 660:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 661:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 662:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 663:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 664:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 665:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 666:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 667:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 668:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 669:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 670:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 671:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 672:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 673:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 674:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 675:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 676:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 677:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 678:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 679:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 680:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 681:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 682:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 683:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 684:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 685:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 686:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 687:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 688:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 689:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 690:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 691:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 692:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 693:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 694:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 695:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 696:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 697:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 698:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 699:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 700:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 701:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 702:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 703:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 704:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 705:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 706:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 707:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 708:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 709:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 710:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 711:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 712:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 713:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 714:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 715:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 716:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 717:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 718:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 719:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 720:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 721:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 722:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 723:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 724:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 725:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 726:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 727:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 728:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 729:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 730:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 731:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 732:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 733:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 734:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 735:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 736:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 737:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 738:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 739:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 740:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 741:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 742:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 743:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 744:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 745:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 746:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 747:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 748:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 749:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 750:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 751:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 752:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 753:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 754:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 755:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 756:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 757:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 758:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 759:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 760:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 761:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 762:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 763:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 764:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 765:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 766:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 767:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 768:
+		return struct {
+			driver.Conn
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 769:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 770:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 771:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 772:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 773:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 774:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 775:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 776:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 777:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 778:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 779:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 780:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 781:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 782:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 783:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 784:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 785:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 786:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 787:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 788:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 789:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 790:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 791:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 792:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 793:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 794:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 795:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 796:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 797:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 798:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 799:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 800:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 801:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 802:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 803:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 804:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 805:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 806:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 807:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 808:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 809:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 810:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 811:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 812:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 813:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 814:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 815:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 816:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 817:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 818:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 819:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 820:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 821:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 822:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 823:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 824:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 825:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 826:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 827:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 828:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 829:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 830:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 831:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 832:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 833:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 834:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 835:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 836:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 837:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 838:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 839:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 840:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 841:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 842:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 843:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 844:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 845:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 846:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 847:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 848:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 849:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 850:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 851:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 852:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 853:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 854:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 855:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 856:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 857:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 858:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 859:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 860:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 861:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 862:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 863:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 864:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 865:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 866:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 867:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 868:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 869:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 870:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 871:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 872:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 873:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 874:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 875:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 876:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 877:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 878:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 879:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 880:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 881:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 882:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 883:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 884:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 885:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 886:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 887:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 888:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 889:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 890:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 891:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 892:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 893:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 894:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 895:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 896:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 897:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 898:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 899:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 900:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 901:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 902:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 903:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 904:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 905:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 906:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 907:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 908:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 909:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 910:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 911:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 912:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 913:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 914:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 915:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 916:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 917:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 918:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 919:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 920:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 921:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 922:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 923:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 924:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 925:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 926:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 927:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 928:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 929:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 930:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 931:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 932:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 933:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 934:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 935:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 936:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 937:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 938:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 939:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 940:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 941:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 942:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 943:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 944:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 945:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 946:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 947:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 948:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 949:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 950:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 951:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 952:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 953:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 954:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 955:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 956:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 957:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 958:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 959:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 960:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 961:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 962:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 963:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 964:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 965:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 966:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 967:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 968:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 969:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 970:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 971:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 972:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 973:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 974:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 975:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 976:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 977:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 978:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 979:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 980:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 981:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 982:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 983:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 984:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 985:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 986:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 987:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 988:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 989:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 990:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 991:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 992:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 993:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 994:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 995:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 996:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 997:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 998:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 999:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1000:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 1001:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1002:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1003:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1004:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1005:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1006:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1007:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 1008:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 1009:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1010:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1011:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1012:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1013:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1014:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1015:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 1016:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 1017:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1018:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1019:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 1020:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 1021:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 1022:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 1023:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w, w}
+	}
+	panic("dbstats: unreachable")
+}
+
+const (
+	stmtColumnConverter = 1 << iota
+	stmtNamedValueChecker
+	stmtExecContext
+	stmtQueryContext
+)
+
+// wrapStmt returns w as a driver.Stmt that implements exactly the optional interfaces
+// that w.wrapped implements.
+func wrapStmt(w *statsStmt) driver.Stmt {
+	var mask int
+	if _, ok := w.wrapped.(columnConverter); ok {
+		mask |= stmtColumnConverter
+	}
+	if _, ok := w.wrapped.(driver.NamedValueChecker); ok {
+		mask |= stmtNamedValueChecker
+	}
+	if _, ok := w.wrapped.(driver.StmtExecContext); ok {
+		mask |= stmtExecContext
+	}
+	if _, ok := w.wrapped.(driver.StmtQueryContext); ok {
+		mask |= stmtQueryContext
+	}
+	switch mask {
+	case 0:
+		return struct{ driver.Stmt }{w}
+	case 1:
+		return struct {
+			driver.Stmt
+			columnConverter
+		}{w, w}
+	case 2:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+		}{w, w}
+	case 3:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 4:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+		}{w, w}
+	case 5:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.StmtExecContext
+		}{w, w, w}
+	case 6:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+			driver.StmtExecContext
+		}{w, w, w}
+	case 7:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+			driver.StmtExecContext
+		}{w, w, w, w}
+	case 8:
+		return struct {
+			driver.Stmt
+			driver.StmtQueryContext
+		}{w, w}
+	case 9:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.StmtQueryContext
+		}{w, w, w}
+	case 10:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+			driver.StmtQueryContext
+		}{w, w, w}
+	case 11:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+			driver.StmtQueryContext
+		}{w, w, w, w}
+	case 12:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{w, w, w}
+	case 13:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{w, w, w, w}
+	case 14:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{w, w, w, w}
+	case 15:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{w, w, w, w, w}
+	}
+	panic("dbstats: unreachable")
+}
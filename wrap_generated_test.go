@@ -0,0 +1,9331 @@
+// Code generated by wrap_gen.go; DO NOT EDIT.
+
+package dbstats
+
+import "database/sql/driver"
+
+// newFakeConn returns a driver.Conn that implements the optional interfaces selected by
+// mask, and only those.
+func newFakeConn(mask int) driver.Conn {
+	f := &fakeFullConn{}
+	switch mask {
+	case 0:
+		return struct{ driver.Conn }{f}
+	case 1:
+		return struct {
+			driver.Conn
+			driver.Queryer
+		}{f, f}
+	case 2:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+		}{f, f}
+	case 3:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+		}{f, f, f}
+	case 4:
+		return struct {
+			driver.Conn
+			driver.Execer
+		}{f, f}
+	case 5:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+		}{f, f, f}
+	case 6:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+		}{f, f, f}
+	case 7:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+		}{f, f, f, f}
+	case 8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+		}{f, f}
+	case 9:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+		}{f, f, f}
+	case 10:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+		}{f, f, f}
+	case 11:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+		}{f, f, f, f}
+	case 12:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+		}{f, f, f}
+	case 13:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+		}{f, f, f, f}
+	case 14:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+		}{f, f, f, f}
+	case 15:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+		}{f, f, f, f, f}
+	case 16:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+		}{f, f}
+	case 17:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+		}{f, f, f}
+	case 18:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+		}{f, f, f}
+	case 19:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f}
+	case 20:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+		}{f, f, f}
+	case 21:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+		}{f, f, f, f}
+	case 22:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+		}{f, f, f, f}
+	case 23:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+		}{f, f, f, f, f}
+	case 24:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f}
+	case 25:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f}
+	case 26:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f}
+	case 27:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f, f}
+	case 28:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f}
+	case 29:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f, f}
+	case 30:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f, f}
+	case 31:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+		}{f, f, f, f, f, f}
+	case 32:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+		}{f, f}
+	case 33:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+		}{f, f, f}
+	case 34:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+		}{f, f, f}
+	case 35:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 36:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+		}{f, f, f}
+	case 37:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 38:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 39:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 40:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f}
+	case 41:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 42:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 43:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 44:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 45:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 46:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 47:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f, f}
+	case 48:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f}
+	case 49:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 50:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 51:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 52:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 53:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 54:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 55:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f, f}
+	case 56:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f}
+	case 57:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 58:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 59:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f, f}
+	case 60:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f}
+	case 61:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f, f}
+	case 62:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f, f}
+	case 63:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+		}{f, f, f, f, f, f, f}
+	case 64:
+		return struct {
+			driver.Conn
+			driver.Pinger
+		}{f, f}
+	case 65:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+		}{f, f, f}
+	case 66:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+		}{f, f, f}
+	case 67:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 68:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+		}{f, f, f}
+	case 69:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+		}{f, f, f, f}
+	case 70:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+		}{f, f, f, f}
+	case 71:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 72:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f}
+	case 73:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 74:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 75:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 76:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 77:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 78:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 79:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 80:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f}
+	case 81:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 82:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 83:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 84:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 85:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 86:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 87:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 88:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f}
+	case 89:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 90:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 91:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 92:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 93:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 94:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 95:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{f, f, f, f, f, f, f}
+	case 96:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f}
+	case 97:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f}
+	case 98:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f}
+	case 99:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 100:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f}
+	case 101:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 102:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 103:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 104:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f}
+	case 105:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 106:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 107:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 108:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 109:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 110:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 111:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f, f}
+	case 112:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f}
+	case 113:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 114:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 115:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 116:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 117:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 118:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 119:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f, f}
+	case 120:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f}
+	case 121:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 122:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 123:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f, f}
+	case 124:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f}
+	case 125:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f, f}
+	case 126:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f, f}
+	case 127:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+		}{f, f, f, f, f, f, f, f}
+	case 128:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+		}{f, f}
+	case 129:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+		}{f, f, f}
+	case 130:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+		}{f, f, f}
+	case 131:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 132:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+		}{f, f, f}
+	case 133:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 134:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 135:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 136:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f}
+	case 137:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 138:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 139:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 140:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 141:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 142:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 143:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 144:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f}
+	case 145:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 146:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 147:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 148:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 149:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 150:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 151:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 152:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 153:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 154:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 155:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 156:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 157:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 158:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 159:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 160:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f}
+	case 161:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 162:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 163:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 164:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 165:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 166:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 167:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 168:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 169:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 170:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 171:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 172:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 173:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 174:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 175:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 176:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 177:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 178:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 179:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 180:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 181:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 182:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 183:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 184:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 185:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 186:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 187:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 188:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 189:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 190:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 191:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 192:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f}
+	case 193:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 194:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 195:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 196:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 197:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 198:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 199:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 200:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 201:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 202:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 203:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 204:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 205:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 206:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 207:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 208:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 209:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 210:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 211:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 212:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 213:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 214:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 215:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 216:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 217:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 218:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 219:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 220:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 221:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 222:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 223:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 224:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f}
+	case 225:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 226:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 227:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 228:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 229:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 230:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 231:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 232:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 233:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 234:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 235:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 236:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 237:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 238:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 239:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 240:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f}
+	case 241:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 242:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 243:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 244:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 245:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 246:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 247:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 248:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f}
+	case 249:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 250:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 251:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 252:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f}
+	case 253:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 254:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f}
+	case 255:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{f, f, f, f, f, f, f, f, f}
+	case 256:
+		return struct {
+			driver.Conn
+			driver.Validator
+		}{f, f}
+	case 257:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Validator
+		}{f, f, f}
+	case 258:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Validator
+		}{f, f, f}
+	case 259:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{f, f, f, f}
+	case 260:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Validator
+		}{f, f, f}
+	case 261:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Validator
+		}{f, f, f, f}
+	case 262:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+		}{f, f, f, f}
+	case 263:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+		}{f, f, f, f, f}
+	case 264:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f}
+	case 265:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f}
+	case 266:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f}
+	case 267:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 268:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f}
+	case 269:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 270:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 271:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 272:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f}
+	case 273:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f}
+	case 274:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f}
+	case 275:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 276:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f}
+	case 277:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 278:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 279:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 280:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f}
+	case 281:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 282:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 283:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 284:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f}
+	case 285:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 286:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 287:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 288:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f}
+	case 289:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f}
+	case 290:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f}
+	case 291:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 292:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f}
+	case 293:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 294:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 295:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 296:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f}
+	case 297:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 298:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 299:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 300:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 301:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 302:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 303:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 304:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f}
+	case 305:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 306:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 307:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 308:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 309:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 310:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 311:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 312:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f}
+	case 313:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 314:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 315:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 316:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 317:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 318:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 319:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 320:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.Validator
+		}{f, f, f}
+	case 321:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f}
+	case 322:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f}
+	case 323:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 324:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f}
+	case 325:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 326:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 327:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 328:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f}
+	case 329:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 330:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 331:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 332:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 333:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 334:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 335:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 336:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f}
+	case 337:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 338:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 339:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 340:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 341:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 342:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 343:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 344:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 345:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 346:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 347:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 348:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 349:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 350:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 351:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 352:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f}
+	case 353:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 354:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 355:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 356:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 357:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 358:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 359:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 360:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 361:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 362:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 363:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 364:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 365:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 366:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 367:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 368:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f}
+	case 369:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 370:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 371:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 372:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 373:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 374:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 375:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 376:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 377:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 378:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 379:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 380:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 381:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 382:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 383:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 384:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f}
+	case 385:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 386:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 387:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 388:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 389:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 390:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 391:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 392:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 393:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 394:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 395:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 396:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 397:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 398:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 399:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 400:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 401:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 402:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 403:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 404:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 405:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 406:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 407:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 408:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 409:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 410:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 411:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 412:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 413:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 414:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 415:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 416:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 417:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 418:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 419:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 420:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 421:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 422:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 423:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 424:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 425:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 426:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 427:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 428:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 429:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 430:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 431:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 432:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 433:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 434:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 435:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 436:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 437:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 438:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 439:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 440:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 441:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 442:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 443:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 444:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 445:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 446:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 447:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 448:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f}
+	case 449:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 450:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 451:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 452:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 453:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 454:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 455:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 456:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 457:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 458:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 459:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 460:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 461:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 462:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 463:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 464:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 465:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 466:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 467:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 468:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 469:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 470:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 471:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 472:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 473:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 474:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 475:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 476:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 477:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 478:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 479:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 480:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f}
+	case 481:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 482:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 483:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 484:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 485:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 486:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 487:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 488:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 489:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 490:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 491:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 492:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 493:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 494:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 495:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 496:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f}
+	case 497:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 498:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 499:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 500:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 501:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 502:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 503:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 504:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f}
+	case 505:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 506:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 507:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 508:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f}
+	case 509:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 510:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f}
+	case 511:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 512:
+		return struct {
+			driver.Conn
+			driver.NamedValueChecker
+		}{f, f}
+	case 513:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 514:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 515:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 516:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 517:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 518:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 519:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 520:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 521:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 522:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 523:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 524:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 525:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 526:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 527:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 528:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 529:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 530:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 531:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 532:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 533:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 534:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 535:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 536:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 537:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 538:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 539:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 540:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 541:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 542:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 543:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 544:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 545:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 546:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 547:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 548:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 549:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 550:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 551:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 552:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 553:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 554:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 555:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 556:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 557:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 558:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 559:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 560:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 561:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 562:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 563:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 564:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 565:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 566:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 567:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 568:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 569:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 570:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 571:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 572:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 573:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 574:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 575:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 576:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 577:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 578:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 579:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 580:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 581:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 582:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 583:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 584:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 585:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 586:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 587:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 588:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 589:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 590:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 591:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 592:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 593:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 594:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 595:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 596:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 597:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 598:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 599:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 600:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 601:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 602:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 603:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 604:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 605:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 606:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 607:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 608:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 609:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 610:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 611:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 612:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 613:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 614:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 615:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 616:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 617:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 618:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 619:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 620:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 621:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 622:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 623:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 624:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 625:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 626:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 627:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 628:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 629:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 630:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 631:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 632:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 633:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 634:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 635:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 636:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 637:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 638:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 639:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 640:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 641:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 642:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 643:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 644:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 645:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 646:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 647:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 648:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 649:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 650:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 651:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 652:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 653:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 654:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 655:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 656:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 657:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 658:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 659:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 660:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 661:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 662:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 663:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 664:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 665:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 666:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 667:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 668:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 669:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 670:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 671:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 672:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 673:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 674:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 675:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 676:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 677:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 678:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 679:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 680:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 681:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 682:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 683:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 684:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 685:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 686:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 687:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 688:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 689:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 690:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 691:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 692:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 693:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 694:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 695:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 696:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 697:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 698:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 699:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 700:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 701:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 702:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 703:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 704:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 705:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 706:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 707:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 708:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 709:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 710:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 711:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 712:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 713:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 714:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 715:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 716:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 717:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 718:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 719:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 720:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 721:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 722:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 723:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 724:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 725:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 726:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 727:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 728:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 729:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 730:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 731:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 732:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 733:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 734:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 735:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 736:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 737:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 738:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 739:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 740:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 741:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 742:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 743:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 744:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 745:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 746:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 747:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 748:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 749:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 750:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 751:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 752:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 753:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 754:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 755:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 756:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 757:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 758:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 759:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 760:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 761:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 762:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 763:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 764:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 765:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 766:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 767:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 768:
+		return struct {
+			driver.Conn
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 769:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 770:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 771:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 772:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 773:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 774:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 775:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 776:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 777:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 778:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 779:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 780:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 781:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 782:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 783:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 784:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 785:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 786:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 787:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 788:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 789:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 790:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 791:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 792:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 793:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 794:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 795:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 796:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 797:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 798:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 799:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 800:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 801:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 802:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 803:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 804:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 805:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 806:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 807:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 808:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 809:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 810:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 811:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 812:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 813:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 814:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 815:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 816:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 817:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 818:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 819:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 820:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 821:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 822:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 823:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 824:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 825:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 826:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 827:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 828:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 829:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 830:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 831:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 832:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 833:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 834:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 835:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 836:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 837:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 838:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 839:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 840:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 841:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 842:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 843:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 844:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 845:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 846:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 847:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 848:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 849:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 850:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 851:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 852:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 853:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 854:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 855:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 856:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 857:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 858:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 859:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 860:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 861:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 862:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 863:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 864:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 865:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 866:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 867:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 868:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 869:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 870:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 871:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 872:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 873:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 874:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 875:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 876:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 877:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 878:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 879:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 880:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 881:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 882:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 883:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 884:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 885:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 886:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 887:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 888:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 889:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 890:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 891:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 892:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 893:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 894:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 895:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 896:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f}
+	case 897:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 898:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 899:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 900:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 901:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 902:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 903:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 904:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 905:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 906:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 907:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 908:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 909:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 910:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 911:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 912:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 913:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 914:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 915:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 916:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 917:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 918:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 919:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 920:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 921:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 922:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 923:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 924:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 925:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 926:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 927:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 928:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 929:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 930:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 931:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 932:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 933:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 934:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 935:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 936:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 937:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 938:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 939:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 940:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 941:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 942:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 943:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 944:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 945:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 946:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 947:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 948:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 949:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 950:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 951:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 952:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 953:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 954:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 955:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 956:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 957:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 958:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 959:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 960:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f}
+	case 961:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 962:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 963:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 964:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 965:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 966:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 967:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 968:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 969:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 970:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 971:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 972:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 973:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 974:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 975:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 976:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 977:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 978:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 979:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 980:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 981:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 982:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 983:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 984:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 985:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 986:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 987:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 988:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 989:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 990:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 991:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 992:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f}
+	case 993:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 994:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 995:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 996:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 997:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 998:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 999:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1000:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 1001:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1002:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1003:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1004:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1005:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1006:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1007:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 1008:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f}
+	case 1009:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1010:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1011:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1012:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1013:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1014:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1015:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 1016:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f}
+	case 1017:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1018:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1019:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 1020:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f}
+	case 1021:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 1022:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f}
+	case 1023:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Execer
+			driver.ExecerContext
+			driver.ConnPrepareContext
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{f, f, f, f, f, f, f, f, f, f, f}
+	}
+	panic("dbstats: invalid mask")
+}
+
+// newFakeStmt returns a driver.Stmt that implements the optional interfaces selected by
+// mask, and only those.
+func newFakeStmt(mask int) driver.Stmt {
+	f := &fakeFullStmt{}
+	switch mask {
+	case 0:
+		return struct{ driver.Stmt }{f}
+	case 1:
+		return struct {
+			driver.Stmt
+			columnConverter
+		}{f, f}
+	case 2:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+		}{f, f}
+	case 3:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+		}{f, f, f}
+	case 4:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+		}{f, f}
+	case 5:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.StmtExecContext
+		}{f, f, f}
+	case 6:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+			driver.StmtExecContext
+		}{f, f, f}
+	case 7:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+			driver.StmtExecContext
+		}{f, f, f, f}
+	case 8:
+		return struct {
+			driver.Stmt
+			driver.StmtQueryContext
+		}{f, f}
+	case 9:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.StmtQueryContext
+		}{f, f, f}
+	case 10:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+			driver.StmtQueryContext
+		}{f, f, f}
+	case 11:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+			driver.StmtQueryContext
+		}{f, f, f, f}
+	case 12:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{f, f, f}
+	case 13:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{f, f, f, f}
+	case 14:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{f, f, f, f}
+	case 15:
+		return struct {
+			driver.Stmt
+			columnConverter
+			driver.NamedValueChecker
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{f, f, f, f, f}
+	}
+	panic("dbstats: invalid mask")
+}
@@ -0,0 +1,489 @@
+// Code generated by wrap_gen.go; DO NOT EDIT.
+
+//go:build !go1.27
+
+package dbstats
+
+import "database/sql/driver"
+
+const (
+	rowsScanType = 1 << iota
+	rowsDatabaseTypeName
+	rowsLength
+	rowsNullable
+	rowsPrecisionScale
+	rowsResultSets
+)
+
+// wrapRows returns w as a driver.Rows that implements exactly the optional interfaces
+// that w.wrapped implements.
+func wrapRows(w *statsRows) driver.Rows {
+	var mask int
+	if _, ok := w.wrapped.(rowsColumnTypeScanType); ok {
+		mask |= rowsScanType
+	}
+	if _, ok := w.wrapped.(rowsColumnTypeDatabaseTypeName); ok {
+		mask |= rowsDatabaseTypeName
+	}
+	if _, ok := w.wrapped.(rowsColumnTypeLength); ok {
+		mask |= rowsLength
+	}
+	if _, ok := w.wrapped.(rowsColumnTypeNullable); ok {
+		mask |= rowsNullable
+	}
+	if _, ok := w.wrapped.(rowsColumnTypePrecisionScale); ok {
+		mask |= rowsPrecisionScale
+	}
+	if _, ok := w.wrapped.(rowsNextResultSet); ok {
+		mask |= rowsResultSets
+	}
+	switch mask {
+	case 0:
+		return struct{ driver.Rows }{w}
+	case 1:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+		}{w, w}
+	case 2:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+		}{w, w}
+	case 3:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+		}{w, w, w}
+	case 4:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+		}{w, w}
+	case 5:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+		}{w, w, w}
+	case 6:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{w, w, w}
+	case 7:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{w, w, w, w}
+	case 8:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+		}{w, w}
+	case 9:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 10:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 11:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 12:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 13:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 14:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 15:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w, w}
+	case 16:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+		}{w, w}
+	case 17:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 18:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 19:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 20:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 21:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 22:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 23:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 24:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 25:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 26:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 27:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 28:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 29:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 30:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 31:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w, w}
+	case 32:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+		}{w, w}
+	case 33:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsNextResultSet
+		}{w, w, w}
+	case 34:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+		}{w, w, w}
+	case 35:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 36:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w}
+	case 37:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 38:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 39:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 40:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w}
+	case 41:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 42:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 43:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 44:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 45:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 46:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 47:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 48:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w}
+	case 49:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 50:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 51:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 52:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 53:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 54:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 55:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 56:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 57:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 58:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 59:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 60:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 61:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 62:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 63:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w, w}
+	}
+	panic("dbstats: unreachable")
+}
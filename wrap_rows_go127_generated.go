@@ -0,0 +1,1005 @@
+// Code generated by wrap_gen.go; DO NOT EDIT.
+
+//go:build go1.27
+
+package dbstats
+
+import "database/sql/driver"
+
+const (
+	rowsScanType = 1 << iota
+	rowsDatabaseTypeName
+	rowsLength
+	rowsNullable
+	rowsPrecisionScale
+	rowsResultSets
+	rowsScanColumn
+)
+
+// wrapRows returns w as a driver.Rows that implements exactly the optional interfaces
+// that w.wrapped implements.
+func wrapRows(w *statsRows) driver.Rows {
+	var mask int
+	if _, ok := w.wrapped.(rowsColumnTypeScanType); ok {
+		mask |= rowsScanType
+	}
+	if _, ok := w.wrapped.(rowsColumnTypeDatabaseTypeName); ok {
+		mask |= rowsDatabaseTypeName
+	}
+	if _, ok := w.wrapped.(rowsColumnTypeLength); ok {
+		mask |= rowsLength
+	}
+	if _, ok := w.wrapped.(rowsColumnTypeNullable); ok {
+		mask |= rowsNullable
+	}
+	if _, ok := w.wrapped.(rowsColumnTypePrecisionScale); ok {
+		mask |= rowsPrecisionScale
+	}
+	if _, ok := w.wrapped.(rowsNextResultSet); ok {
+		mask |= rowsResultSets
+	}
+	if _, ok := w.wrapped.(rowsColumnScanner); ok {
+		mask |= rowsScanColumn
+	}
+	switch mask {
+	case 0:
+		return struct{ driver.Rows }{w}
+	case 1:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+		}{w, w}
+	case 2:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+		}{w, w}
+	case 3:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+		}{w, w, w}
+	case 4:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+		}{w, w}
+	case 5:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+		}{w, w, w}
+	case 6:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{w, w, w}
+	case 7:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{w, w, w, w}
+	case 8:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+		}{w, w}
+	case 9:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 10:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 11:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 12:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 13:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 14:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 15:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w, w}
+	case 16:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+		}{w, w}
+	case 17:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 18:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 19:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 20:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 21:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 22:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 23:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 24:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 25:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 26:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 27:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 28:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 29:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 30:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 31:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w, w}
+	case 32:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+		}{w, w}
+	case 33:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsNextResultSet
+		}{w, w, w}
+	case 34:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+		}{w, w, w}
+	case 35:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 36:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w}
+	case 37:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 38:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 39:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 40:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w}
+	case 41:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 42:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 43:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 44:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 45:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 46:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 47:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 48:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w}
+	case 49:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 50:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 51:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 52:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 53:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 54:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 55:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 56:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w}
+	case 57:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 58:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 59:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 60:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w}
+	case 61:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 62:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w}
+	case 63:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{w, w, w, w, w, w, w}
+	case 64:
+		return struct {
+			driver.Rows
+			rowsColumnScanner
+		}{w, w}
+	case 65:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnScanner
+		}{w, w, w}
+	case 66:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnScanner
+		}{w, w, w}
+	case 67:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 68:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{w, w, w}
+	case 69:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 70:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 71:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 72:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w}
+	case 73:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 74:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 75:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 76:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 77:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 78:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 79:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 80:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w}
+	case 81:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 82:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 83:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 84:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 85:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 86:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 87:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 88:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 89:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 90:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 91:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 92:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 93:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 94:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 95:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w}
+	case 96:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w}
+	case 97:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 98:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 99:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 100:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 101:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 102:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 103:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 104:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 105:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 106:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 107:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 108:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 109:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 110:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 111:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w}
+	case 112:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w}
+	case 113:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 114:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 115:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 116:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 117:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 118:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 119:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w}
+	case 120:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w}
+	case 121:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 122:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 123:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w}
+	case 124:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w}
+	case 125:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w}
+	case 126:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w}
+	case 127:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{w, w, w, w, w, w, w, w}
+	}
+	panic("dbstats: unreachable")
+}
@@ -0,0 +1,974 @@
+// Code generated by wrap_gen.go; DO NOT EDIT.
+
+//go:build go1.27
+
+package dbstats
+
+import "database/sql/driver"
+
+// newFakeRows returns a driver.Rows that implements the optional interfaces selected by
+// mask, and only those.
+func newFakeRows(mask int) driver.Rows {
+	f := &fakeFullRows{}
+	switch mask {
+	case 0:
+		return struct{ driver.Rows }{f}
+	case 1:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+		}{f, f}
+	case 2:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+		}{f, f}
+	case 3:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+		}{f, f, f}
+	case 4:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+		}{f, f}
+	case 5:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+		}{f, f, f}
+	case 6:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{f, f, f}
+	case 7:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{f, f, f, f}
+	case 8:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+		}{f, f}
+	case 9:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+		}{f, f, f}
+	case 10:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{f, f, f}
+	case 11:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{f, f, f, f}
+	case 12:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{f, f, f}
+	case 13:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{f, f, f, f}
+	case 14:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{f, f, f, f}
+	case 15:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{f, f, f, f, f}
+	case 16:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+		}{f, f}
+	case 17:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+		}{f, f, f}
+	case 18:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{f, f, f}
+	case 19:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f}
+	case 20:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{f, f, f}
+	case 21:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f}
+	case 22:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f}
+	case 23:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f, f}
+	case 24:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f}
+	case 25:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f}
+	case 26:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f}
+	case 27:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f, f}
+	case 28:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f}
+	case 29:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f, f}
+	case 30:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f, f}
+	case 31:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{f, f, f, f, f, f}
+	case 32:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+		}{f, f}
+	case 33:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsNextResultSet
+		}{f, f, f}
+	case 34:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+		}{f, f, f}
+	case 35:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 36:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{f, f, f}
+	case 37:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 38:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 39:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 40:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f}
+	case 41:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 42:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 43:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 44:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 45:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 46:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 47:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+		}{f, f, f, f, f, f}
+	case 48:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f}
+	case 49:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 50:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 51:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 52:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 53:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 54:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 55:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f, f}
+	case 56:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f}
+	case 57:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 58:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 59:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f, f}
+	case 60:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f}
+	case 61:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f, f}
+	case 62:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f, f}
+	case 63:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+		}{f, f, f, f, f, f, f}
+	case 64:
+		return struct {
+			driver.Rows
+			rowsColumnScanner
+		}{f, f}
+	case 65:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnScanner
+		}{f, f, f}
+	case 66:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnScanner
+		}{f, f, f}
+	case 67:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 68:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{f, f, f}
+	case 69:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 70:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 71:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 72:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f}
+	case 73:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 74:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 75:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 76:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 77:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 78:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 79:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 80:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f}
+	case 81:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 82:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 83:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 84:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 85:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 86:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 87:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 88:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 89:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 90:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 91:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 92:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 93:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 94:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 95:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f}
+	case 96:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f}
+	case 97:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 98:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 99:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 100:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 101:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 102:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 103:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 104:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 105:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 106:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 107:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 108:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 109:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 110:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 111:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f}
+	case 112:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f}
+	case 113:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 114:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 115:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 116:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 117:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 118:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 119:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f}
+	case 120:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f}
+	case 121:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 122:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 123:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f}
+	case 124:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f}
+	case 125:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f}
+	case 126:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f}
+	case 127:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsNextResultSet
+			rowsColumnScanner
+		}{f, f, f, f, f, f, f, f}
+	}
+	panic("dbstats: invalid mask")
+}
@@ -0,0 +1,177 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"testing"
+)
+
+// fakeFullConn, fakeFullStmt and fakeFullRows implement every optional interface that
+// the wrappers forward. The generated newFakeConn, newFakeStmt and newFakeRows embed
+// them so that only a chosen subset of the interfaces is visible.
+type fakeFullConn struct{ fakeConn }
+
+func (c *fakeFullConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{}, nil
+}
+func (c *fakeFullConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{}, nil
+}
+func (c *fakeFullConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return &fakeResult{}, nil
+}
+func (c *fakeFullConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return &fakeResult{}, nil
+}
+func (c *fakeFullConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return &fakeStmt{}, nil
+}
+func (c *fakeFullConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return &fakeTx{}, nil
+}
+func (c *fakeFullConn) Ping(ctx context.Context) error {
+	return nil
+}
+func (c *fakeFullConn) ResetSession(ctx context.Context) error {
+	return nil
+}
+func (c *fakeFullConn) IsValid() bool {
+	return true
+}
+func (c *fakeFullConn) CheckNamedValue(nv *driver.NamedValue) error {
+	return driver.ErrSkip
+}
+
+type fakeFullStmt struct{ fakeStmt }
+
+func (s *fakeFullStmt) ColumnConverter(idx int) driver.ValueConverter {
+	return passthroughValueConverter{}
+}
+func (s *fakeFullStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	return driver.ErrSkip
+}
+func (s *fakeFullStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return &fakeResult{}, nil
+}
+func (s *fakeFullStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{}, nil
+}
+
+type fakeFullRows struct{ fakeColumnTypeRows }
+
+func (r *fakeFullRows) HasNextResultSet() bool {
+	return false
+}
+func (r *fakeFullRows) NextResultSet() error {
+	return io.EOF
+}
+
+// assertion reports whether a value implements one of the optional interfaces.
+type assertion struct {
+	name string
+	is   func(v interface{}) bool
+}
+
+var connAssertions = []assertion{
+	{"Queryer", func(v interface{}) bool { _, ok := v.(driver.Queryer); return ok }},
+	{"QueryerContext", func(v interface{}) bool { _, ok := v.(driver.QueryerContext); return ok }},
+	{"Execer", func(v interface{}) bool { _, ok := v.(driver.Execer); return ok }},
+	{"ExecerContext", func(v interface{}) bool { _, ok := v.(driver.ExecerContext); return ok }},
+	{"ConnPrepareContext", func(v interface{}) bool { _, ok := v.(driver.ConnPrepareContext); return ok }},
+	{"ConnBeginTx", func(v interface{}) bool { _, ok := v.(driver.ConnBeginTx); return ok }},
+	{"Pinger", func(v interface{}) bool { _, ok := v.(driver.Pinger); return ok }},
+	{"SessionResetter", func(v interface{}) bool { _, ok := v.(driver.SessionResetter); return ok }},
+	{"Validator", func(v interface{}) bool { _, ok := v.(driver.Validator); return ok }},
+	{"NamedValueChecker", func(v interface{}) bool { _, ok := v.(driver.NamedValueChecker); return ok }},
+}
+
+var stmtAssertions = []assertion{
+	{"ColumnConverter", func(v interface{}) bool { _, ok := v.(driver.ColumnConverter); return ok }},
+	{"NamedValueChecker", func(v interface{}) bool { _, ok := v.(driver.NamedValueChecker); return ok }},
+	{"StmtExecContext", func(v interface{}) bool { _, ok := v.(driver.StmtExecContext); return ok }},
+	{"StmtQueryContext", func(v interface{}) bool { _, ok := v.(driver.StmtQueryContext); return ok }},
+}
+
+var rowsAssertions = []assertion{
+	{"RowsColumnTypeScanType", func(v interface{}) bool { _, ok := v.(driver.RowsColumnTypeScanType); return ok }},
+	{"RowsColumnTypeDatabaseTypeName", func(v interface{}) bool { _, ok := v.(driver.RowsColumnTypeDatabaseTypeName); return ok }},
+	{"RowsColumnTypeLength", func(v interface{}) bool { _, ok := v.(driver.RowsColumnTypeLength); return ok }},
+	{"RowsColumnTypeNullable", func(v interface{}) bool { _, ok := v.(driver.RowsColumnTypeNullable); return ok }},
+	{"RowsColumnTypePrecisionScale", func(v interface{}) bool { _, ok := v.(driver.RowsColumnTypePrecisionScale); return ok }},
+	{"RowsNextResultSet", func(v interface{}) bool { _, ok := v.(driver.RowsNextResultSet); return ok }},
+}
+
+// checkConformance checks that wrapped and unwrapped implement the same interfaces
+// and that unwrapped implements exactly the interfaces selected by mask.
+func checkConformance(t *testing.T, kind string, mask int, assertions []assertion, unwrapped, wrapped interface{}) {
+	for i, a := range assertions {
+		want := mask&(1<<uint(i)) != 0
+		if got := a.is(unwrapped); got != want {
+			t.Fatalf("%s fake for mask %b: implements %s=%t, want %t", kind, mask, a.name, got, want)
+		}
+		if got := a.is(wrapped); got != want {
+			t.Errorf("%s wrapper for mask %b: implements %s=%t, want %t", kind, mask, a.name, got, want)
+		}
+	}
+}
+
+func TestWrapConnConformance(t *testing.T) {
+	d := newStatsDriver(nil)
+	for mask := 0; mask < 1<<uint(len(connAssertions)); mask++ {
+		c := newFakeConn(mask)
+		w := wrapConn(&statsConn{d: d, wrapped: c})
+		checkConformance(t, "conn", mask, connAssertions, c, w)
+	}
+}
+
+func TestWrapStmtConformance(t *testing.T) {
+	d := newStatsDriver(nil)
+	for mask := 0; mask < 1<<uint(len(stmtAssertions)); mask++ {
+		s := newFakeStmt(mask)
+		w := wrapStmt(&statsStmt{d: d, wrapped: s})
+		checkConformance(t, "stmt", mask, stmtAssertions, s, w)
+	}
+}
+
+func TestWrapRowsConformance(t *testing.T) {
+	d := newStatsDriver(nil)
+	for mask := 0; mask < 1<<uint(len(rowsAssertions)); mask++ {
+		r := newFakeRows(mask)
+		w := wrapRows(&statsRows{d: d, wrapped: r})
+		checkConformance(t, "rows", mask, rowsAssertions, r, w)
+	}
+}
+
+func TestWrappedConnForwardsToWrapped(t *testing.T) {
+	reset()
+	d := newStatsDriver(nil)
+	d.AddHook(hook)
+	all := 1<<uint(len(connAssertions)) - 1
+	w := wrapConn(&statsConn{d: d, wrapped: newFakeConn(all)})
+	ctx := context.Background()
+
+	w.(driver.Queryer).Query("SELECT 1", nil)
+	w.(driver.QueryerContext).QueryContext(ctx, "SELECT 1", nil)
+	w.(driver.Execer).Exec("UPDATE t SET c=1", nil)
+	w.(driver.ExecerContext).ExecContext(ctx, "UPDATE t SET c=1", nil)
+	w.(driver.ConnPrepareContext).PrepareContext(ctx, "SELECT 1")
+	w.(driver.ConnBeginTx).BeginTx(ctx, driver.TxOptions{})
+	w.(driver.Pinger).Ping(ctx)
+	w.(driver.SessionResetter).ResetSession(ctx)
+
+	switch {
+	case hook.queriedCount != 2:
+		t.Errorf("Expected Queried to be called 2 times, got %d", hook.queriedCount)
+	case hook.execedCount != 2:
+		t.Errorf("Expected Execed to be called 2 times, got %d", hook.execedCount)
+	case hook.stmtPreparedCount != 1:
+		t.Errorf("Expected StmtPrepared to be called 1 time, got %d", hook.stmtPreparedCount)
+	case hook.txBeganCount != 1:
+		t.Errorf("Expected TxBegan to be called 1 time, got %d", hook.txBeganCount)
+	case len(hook.pingErrs) != 1:
+		t.Errorf("Expected Pinged to be called 1 time, got %d", len(hook.pingErrs))
+	case len(hook.resetErrs) != 1:
+		t.Errorf("Expected SessionReset to be called 1 time, got %d", len(hook.resetErrs))
+	}
+}